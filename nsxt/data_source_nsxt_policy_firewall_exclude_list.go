@@ -0,0 +1,50 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall/security"
+)
+
+func dataSourceNsxtPolicyFirewallExcludeList() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyFirewallExcludeListRead,
+
+		Schema: map[string]*schema.Schema{
+			"path": getPathSchema(),
+			"members": {
+				Type:        schema.TypeList,
+				Description: "Policy paths of distributed firewall exclude list members",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyFirewallExcludeListRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return dataSourceNotSupportedError()
+	}
+
+	client := security.NewExcludeListClient(getPolicyConnector(m))
+	obj, err := client.Get()
+	if err != nil {
+		return fmt.Errorf("Error while reading firewall exclude list: %v", err)
+	}
+
+	d.SetId(newUUID())
+	if obj.Id != nil {
+		d.SetId(*obj.Id)
+	}
+	d.Set("path", obj.Path)
+	d.Set("members", obj.Members)
+
+	return nil
+}
@@ -0,0 +1,135 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains/gateway_policies"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains/security_policies"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func getRuleStatisticsCountSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: description,
+		Computed:    true,
+	}
+}
+
+func dataSourceNsxtPolicyFirewallRuleStatistics() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyFirewallRuleStatisticsRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":                     getDataSourceIDSchema(),
+			"policy_path":            getPolicyPathSchema(true, false, "Policy path of security policy or gateway policy"),
+			"enforcement_point_path": getPolicyPathSchema(false, false, "Policy path of enforcement point to fetch statistics from"),
+			"container_cluster_path": getPolicyPathSchema(false, false, "Policy path of container cluster to fetch statistics for"),
+			"rule": {
+				Type:        schema.TypeList,
+				Description: "Statistics of rules in the policy",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the rule",
+							Computed:    true,
+						},
+						"rule_id": {
+							Type:        schema.TypeString,
+							Description: "Realized ID of the rule",
+							Computed:    true,
+						},
+						"enforcement_point": {
+							Type:        schema.TypeString,
+							Description: "Enforcement point the statistics were fetched from",
+							Computed:    true,
+						},
+						"container_cluster_path": {
+							Type:        schema.TypeString,
+							Description: "Container cluster the statistics relate to",
+							Computed:    true,
+						},
+						"gateway_path": {
+							Type:        schema.TypeString,
+							Description: "Path of gateway the rule is applied on, for gateway firewall",
+							Computed:    true,
+						},
+						"hit_count":        getRuleStatisticsCountSchema("Aggregated number of hits received by the rule"),
+						"session_count":    getRuleStatisticsCountSchema("Aggregated number of sessions processed by the rule"),
+						"byte_count":       getRuleStatisticsCountSchema("Aggregated number of bytes processed by the rule"),
+						"packet_count":     getRuleStatisticsCountSchema("Aggregated number of packets processed by the rule"),
+						"popularity_index": getRuleStatisticsCountSchema("Sessions count divided by age of the rule"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyFirewallRuleStatisticsRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return dataSourceNotSupportedError()
+	}
+
+	connector := getPolicyConnector(m)
+	policyPath := d.Get("policy_path").(string)
+	domain := getDomainFromResourcePath(policyPath)
+
+	var enforcementPointPath *string
+	if value, ok := d.GetOk("enforcement_point_path"); ok {
+		path := value.(string)
+		enforcementPointPath = &path
+	}
+	var containerClusterPath *string
+	if value, ok := d.GetOk("container_cluster_path"); ok {
+		path := value.(string)
+		containerClusterPath = &path
+	}
+
+	var stats model.SecurityPolicyStatisticsListResult
+	var err error
+	if policyID := getResourceIDFromResourcePath(policyPath, "security-policies"); domain != "" && policyID != "" {
+		client := security_policies.NewStatisticsClient(connector)
+		stats, err = client.List(domain, policyID, containerClusterPath, enforcementPointPath)
+	} else if policyID := getResourceIDFromResourcePath(policyPath, "gateway-policies"); domain != "" && policyID != "" {
+		client := gateway_policies.NewStatisticsClient(connector)
+		stats, err = client.List(domain, policyID, containerClusterPath, enforcementPointPath)
+	} else {
+		return fmt.Errorf("Expected security policy or gateway policy path, got %s", policyPath)
+	}
+	if err != nil {
+		return fmt.Errorf("Error while reading rule statistics for policy %s: %v", policyPath, err)
+	}
+
+	var ruleList []map[string]interface{}
+	for _, epStats := range stats.Results {
+		if epStats.Statistics == nil {
+			continue
+		}
+		for _, ruleStats := range epStats.Statistics.Results {
+			elem := make(map[string]interface{})
+			elem["rule_path"] = ruleStats.Rule
+			elem["rule_id"] = ruleStats.InternalRuleId
+			elem["enforcement_point"] = epStats.EnforcementPoint
+			elem["container_cluster_path"] = epStats.ContainerClusterPath
+			elem["gateway_path"] = ruleStats.LrPath
+			elem["hit_count"] = ruleStats.HitCount
+			elem["session_count"] = ruleStats.SessionCount
+			elem["byte_count"] = ruleStats.ByteCount
+			elem["packet_count"] = ruleStats.PacketCount
+			elem["popularity_index"] = ruleStats.PopularityIndex
+			ruleList = append(ruleList, elem)
+		}
+	}
+
+	d.Set("rule", ruleList)
+	d.SetId(newUUID())
+
+	return nil
+}
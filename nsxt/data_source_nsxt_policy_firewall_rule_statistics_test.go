@@ -0,0 +1,50 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyFirewallRuleStatistics_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_firewall_rule_statistics.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallRuleStatisticsReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testResourceName, "policy_path", "nsxt_policy_security_policy.test", "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rule.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyFirewallRuleStatisticsReadTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_security_policy" "test" {
+  display_name = "%s"
+  category     = "Application"
+
+  rule {
+    display_name = "rule1"
+    action       = "ALLOW"
+  }
+}
+
+data "nsxt_policy_firewall_rule_statistics" "test" {
+  policy_path = nsxt_policy_security_policy.test.path
+}`, name)
+}
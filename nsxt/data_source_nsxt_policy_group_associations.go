@@ -0,0 +1,151 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var groupAssociationInputKeys = []string{"vm_external_id", "vif_external_id", "ip_address", "member_path"}
+
+func dataSourceNsxtPolicyGroupAssociations() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyGroupAssociationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": getDataSourceIDSchema(),
+			"vm_external_id": {
+				Type:         schema.TypeString,
+				Description:  "External ID of virtual machine to look up groups for",
+				Optional:     true,
+				ExactlyOneOf: groupAssociationInputKeys,
+			},
+			"vif_external_id": {
+				Type:         schema.TypeString,
+				Description:  "External ID of virtual network interface to look up groups for",
+				Optional:     true,
+				ExactlyOneOf: groupAssociationInputKeys,
+			},
+			"ip_address": {
+				Type:         schema.TypeString,
+				Description:  "IP address to look up groups for",
+				Optional:     true,
+				ValidateFunc: validateSingleIP(),
+				ExactlyOneOf: groupAssociationInputKeys,
+			},
+			"member_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of object, such as segment or segment port, to look up groups for",
+				Optional:     true,
+				ValidateFunc: validatePolicyPath(),
+				ExactlyOneOf: groupAssociationInputKeys,
+			},
+			"items": {
+				Type:        schema.TypeList,
+				Description: "Groups the object is a member of",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "ID of the group",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Display name of the group",
+							Computed:    true,
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the group",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyGroupAssociationsRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return dataSourceNotSupportedError()
+	}
+
+	connector := getPolicyConnector(m)
+	enforcementPointPath := getPolicyEnforcementPointPath(m)
+	boolFalse := false
+
+	// All association APIs share the same list result, differing only by the
+	// member identifier
+	var listFunc func(cursor *string) (model.PolicyResourceReferenceForEPListResult, error)
+	var member string
+	if vmID, ok := d.GetOk("vm_external_id"); ok {
+		member = vmID.(string)
+		client := infra.NewVirtualMachineGroupAssociationsClient(connector)
+		listFunc = func(cursor *string) (model.PolicyResourceReferenceForEPListResult, error) {
+			return client.List(member, cursor, &enforcementPointPath, &boolFalse, nil, nil, nil, nil)
+		}
+	} else if vifID, ok := d.GetOk("vif_external_id"); ok {
+		member = vifID.(string)
+		client := infra.NewVirtualNetworkInterfaceGroupAssociationsClient(connector)
+		listFunc = func(cursor *string) (model.PolicyResourceReferenceForEPListResult, error) {
+			return client.List(member, cursor, &enforcementPointPath, &boolFalse, nil, nil, nil, nil)
+		}
+	} else if ip, ok := d.GetOk("ip_address"); ok {
+		member = ip.(string)
+		client := infra.NewIpAddressGroupAssociationsClient(connector)
+		listFunc = func(cursor *string) (model.PolicyResourceReferenceForEPListResult, error) {
+			return client.List(member, cursor, &enforcementPointPath, &boolFalse, nil, nil, nil, nil)
+		}
+	} else {
+		member = d.Get("member_path").(string)
+		client := infra.NewGroupAssociationsClient(connector)
+		listFunc = func(cursor *string) (model.PolicyResourceReferenceForEPListResult, error) {
+			return client.List(member, cursor, &enforcementPointPath, &boolFalse, nil, nil, nil, nil)
+		}
+	}
+
+	var results []model.PolicyResourceReferenceForEP
+	var cursor *string
+	total := 0
+	for {
+		references, err := listFunc(cursor)
+		if err != nil {
+			return fmt.Errorf("Error while reading group associations for %s: %v", member, err)
+		}
+		results = append(results, references.Results...)
+		if total == 0 && references.ResultCount != nil {
+			// first response
+			total = int(*references.ResultCount)
+		}
+
+		cursor = references.Cursor
+		if len(results) >= total || cursor == nil {
+			break
+		}
+	}
+
+	var items []map[string]interface{}
+	for _, reference := range results {
+		if reference.IsValid != nil && !*reference.IsValid {
+			continue
+		}
+		elem := make(map[string]interface{})
+		elem["id"] = reference.TargetId
+		elem["display_name"] = reference.TargetDisplayName
+		elem["path"] = reference.Path
+		items = append(items, elem)
+	}
+
+	d.Set("items", items)
+	d.SetId(newUUID())
+
+	return nil
+}
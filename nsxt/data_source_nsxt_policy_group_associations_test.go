@@ -0,0 +1,51 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyGroupAssociations_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_group_associations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGroupAssociationsReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "items.#"),
+					resource.TestCheckTypeSetElemAttrPair(testResourceName, "items.*.path", "nsxt_policy_group.test", "path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGroupAssociationsReadTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test" {
+  display_name = "%s"
+
+  criteria {
+    ipaddress_expression {
+      ip_addresses = ["10.10.30.5"]
+    }
+  }
+}
+
+data "nsxt_policy_group_associations" "test" {
+  ip_address = "10.10.30.5"
+  depends_on = [nsxt_policy_group.test]
+}`, name)
+}
@@ -0,0 +1,322 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains/groups/members"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func getGroupMemberDetailsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Description: "ID of the member",
+					Computed:    true,
+				},
+				"display_name": {
+					Type:        schema.TypeString,
+					Description: "Display name of the member",
+					Computed:    true,
+				},
+				"path": {
+					Type:        schema.TypeString,
+					Description: "Policy path of the member",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyGroupMembers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyGroupMembersRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":         getDataSourceIDSchema(),
+			"group_path": getPolicyPathSchema(true, false, "Policy path of the group"),
+			"virtual_machines": {
+				Type:        schema.TypeList,
+				Description: "Effective virtual machine members of the group",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "ID of the virtual machine",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Display name of the virtual machine",
+							Computed:    true,
+						},
+						"power_state": {
+							Type:        schema.TypeString,
+							Description: "Power state of the virtual machine",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"ip_addresses": {
+				Type:        schema.TypeList,
+				Description: "Effective IP address members of the group",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"segments":      getGroupMemberDetailsSchema("Effective segment members of the group"),
+			"segment_ports": getGroupMemberDetailsSchema("Effective segment port members of the group"),
+			"vifs": {
+				Type:        schema.TypeList,
+				Description: "Effective VIF members of the group",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"external_id": {
+							Type:        schema.TypeString,
+							Description: "External ID of the VIF",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Display name of the VIF",
+							Computed:    true,
+						},
+						"owner_vm_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the virtual machine this VIF belongs to",
+							Computed:    true,
+						},
+						"mac_address": {
+							Type:        schema.TypeString,
+							Description: "MAC address of the VIF",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// policyGroupMemberPage describes a single page of group members, as needed for pagination
+type policyGroupMemberPage struct {
+	count       int
+	cursor      *string
+	resultCount *int64
+}
+
+// listPolicyGroupMemberPages walks all pages of group members. Member APIs do not always
+// return a cursor, in which case number of results collected so far is used instead.
+func listPolicyGroupMemberPages(listFunc func(cursor *string) (policyGroupMemberPage, error)) error {
+	var cursor *string
+	total := 0
+	collected := 0
+
+	for {
+		page, err := listFunc(cursor)
+		if err != nil {
+			return err
+		}
+		collected += page.count
+		if page.count == 0 {
+			// no more results
+			return nil
+		}
+		if total == 0 && page.resultCount != nil {
+			// first response
+			total = int(*page.resultCount)
+		}
+		cursor = page.cursor
+		if cursor == nil {
+			resultCount := strconv.Itoa(collected)
+			cursor = &resultCount
+		}
+
+		if (total > 0) && (collected >= total) {
+			return nil
+		}
+	}
+}
+
+func listPolicyGroupMemberVirtualMachines(connector *client.RestConnector, domain string, groupID string, enforcementPointPath string) ([]model.RealizedVirtualMachine, error) {
+	client := members.NewVirtualMachinesClient(connector)
+	var results []model.RealizedVirtualMachine
+	boolFalse := false
+
+	err := listPolicyGroupMemberPages(func(cursor *string) (policyGroupMemberPage, error) {
+		page, err := client.List(domain, groupID, cursor, &enforcementPointPath, &boolFalse, nil, nil, nil, nil)
+		if err != nil {
+			return policyGroupMemberPage{}, err
+		}
+		results = append(results, page.Results...)
+		return policyGroupMemberPage{count: len(page.Results), cursor: page.Cursor, resultCount: page.ResultCount}, nil
+	})
+
+	return results, err
+}
+
+func listPolicyGroupMemberIPAddresses(connector *client.RestConnector, domain string, groupID string, enforcementPointPath string) ([]string, error) {
+	client := members.NewIpAddressesClient(connector)
+	var results []string
+	boolFalse := false
+
+	err := listPolicyGroupMemberPages(func(cursor *string) (policyGroupMemberPage, error) {
+		page, err := client.List(domain, groupID, cursor, &enforcementPointPath, &boolFalse, nil, nil, nil, nil)
+		if err != nil {
+			return policyGroupMemberPage{}, err
+		}
+		results = append(results, page.Results...)
+		return policyGroupMemberPage{count: len(page.Results), cursor: page.Cursor, resultCount: page.ResultCount}, nil
+	})
+
+	return results, err
+}
+
+func listPolicyGroupMemberSegments(connector *client.RestConnector, domain string, groupID string, enforcementPointPath string) ([]model.PolicyGroupMemberDetails, error) {
+	client := members.NewSegmentsClient(connector)
+	var results []model.PolicyGroupMemberDetails
+	boolFalse := false
+
+	err := listPolicyGroupMemberPages(func(cursor *string) (policyGroupMemberPage, error) {
+		page, err := client.List(domain, groupID, cursor, &enforcementPointPath, &boolFalse, nil, nil, nil, nil)
+		if err != nil {
+			return policyGroupMemberPage{}, err
+		}
+		results = append(results, page.Results...)
+		return policyGroupMemberPage{count: len(page.Results), cursor: page.Cursor, resultCount: page.ResultCount}, nil
+	})
+
+	return results, err
+}
+
+func listPolicyGroupMemberSegmentPorts(connector *client.RestConnector, domain string, groupID string, enforcementPointPath string) ([]model.PolicyGroupMemberDetails, error) {
+	client := members.NewSegmentPortsClient(connector)
+	var results []model.PolicyGroupMemberDetails
+	boolFalse := false
+
+	err := listPolicyGroupMemberPages(func(cursor *string) (policyGroupMemberPage, error) {
+		page, err := client.List(domain, groupID, cursor, &enforcementPointPath, &boolFalse, nil, nil, nil, nil)
+		if err != nil {
+			return policyGroupMemberPage{}, err
+		}
+		results = append(results, page.Results...)
+		return policyGroupMemberPage{count: len(page.Results), cursor: page.Cursor, resultCount: page.ResultCount}, nil
+	})
+
+	return results, err
+}
+
+func listPolicyGroupMemberVifs(connector *client.RestConnector, domain string, groupID string, enforcementPointPath string) ([]model.VirtualNetworkInterface, error) {
+	client := members.NewVifsClient(connector)
+	var results []model.VirtualNetworkInterface
+	boolFalse := false
+
+	err := listPolicyGroupMemberPages(func(cursor *string) (policyGroupMemberPage, error) {
+		page, err := client.List(domain, groupID, cursor, &enforcementPointPath, &boolFalse, nil, nil, nil, nil)
+		if err != nil {
+			return policyGroupMemberPage{}, err
+		}
+		results = append(results, page.Results...)
+		return policyGroupMemberPage{count: len(page.Results), cursor: page.Cursor, resultCount: page.ResultCount}, nil
+	})
+
+	return results, err
+}
+
+func setGroupMemberDetailsInSchema(d *schema.ResourceData, attrName string, details []model.PolicyGroupMemberDetails) error {
+	var detailList []map[string]interface{}
+	for _, detail := range details {
+		elem := make(map[string]interface{})
+		elem["id"] = detail.Id
+		elem["display_name"] = detail.DisplayName
+		elem["path"] = detail.Path
+		detailList = append(detailList, elem)
+	}
+
+	return d.Set(attrName, detailList)
+}
+
+func dataSourceNsxtPolicyGroupMembersRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return dataSourceNotSupportedError()
+	}
+
+	connector := getPolicyConnector(m)
+	groupPath := d.Get("group_path").(string)
+	domain, groupID, err := parsePolicyGroupPath(groupPath)
+	if err != nil {
+		return err
+	}
+	enforcementPointPath := getPolicyEnforcementPointPath(m)
+
+	vms, err := listPolicyGroupMemberVirtualMachines(connector, domain, groupID, enforcementPointPath)
+	if err != nil {
+		return fmt.Errorf("Error while reading VM members of group %s: %v", groupPath, err)
+	}
+	var vmList []map[string]interface{}
+	for _, vm := range vms {
+		elem := make(map[string]interface{})
+		elem["id"] = vm.Id
+		elem["display_name"] = vm.DisplayName
+		elem["power_state"] = vm.PowerState
+		vmList = append(vmList, elem)
+	}
+	d.Set("virtual_machines", vmList)
+
+	ips, err := listPolicyGroupMemberIPAddresses(connector, domain, groupID, enforcementPointPath)
+	if err != nil {
+		return fmt.Errorf("Error while reading IP address members of group %s: %v", groupPath, err)
+	}
+	d.Set("ip_addresses", ips)
+
+	segments, err := listPolicyGroupMemberSegments(connector, domain, groupID, enforcementPointPath)
+	if err != nil {
+		return fmt.Errorf("Error while reading Segment members of group %s: %v", groupPath, err)
+	}
+	if err := setGroupMemberDetailsInSchema(d, "segments", segments); err != nil {
+		return err
+	}
+
+	ports, err := listPolicyGroupMemberSegmentPorts(connector, domain, groupID, enforcementPointPath)
+	if err != nil {
+		return fmt.Errorf("Error while reading Segment Port members of group %s: %v", groupPath, err)
+	}
+	if err := setGroupMemberDetailsInSchema(d, "segment_ports", ports); err != nil {
+		return err
+	}
+
+	vifs, err := listPolicyGroupMemberVifs(connector, domain, groupID, enforcementPointPath)
+	if err != nil {
+		return fmt.Errorf("Error while reading VIF members of group %s: %v", groupPath, err)
+	}
+	var vifList []map[string]interface{}
+	for _, vif := range vifs {
+		elem := make(map[string]interface{})
+		elem["external_id"] = vif.ExternalId
+		elem["display_name"] = vif.DisplayName
+		elem["owner_vm_id"] = vif.OwnerVmId
+		elem["mac_address"] = vif.MacAddress
+		vifList = append(vifList, elem)
+	}
+	d.Set("vifs", vifList)
+
+	d.SetId(newUUID())
+
+	return nil
+}
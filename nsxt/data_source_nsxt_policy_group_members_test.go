@@ -0,0 +1,89 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyGroupMembers_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_group_members.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGroupMembersReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testResourceName, "group_path", "nsxt_policy_group.test", "path"),
+					resource.TestCheckResourceAttr(testResourceName, "ip_addresses.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "virtual_machines.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "segments.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGroupMembersReadTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test" {
+  display_name = "%s"
+
+  criteria {
+    ipaddress_expression {
+      ip_addresses = ["10.10.10.1", "10.10.20.0/24"]
+    }
+  }
+}
+
+data "nsxt_policy_group_members" "test" {
+  group_path = nsxt_policy_group.test.path
+}`, name)
+}
+
+func TestListPolicyGroupMemberPages(t *testing.T) {
+	count := int64(3)
+	var cursors []string
+
+	err := listPolicyGroupMemberPages(func(cursor *string) (policyGroupMemberPage, error) {
+		if cursor != nil {
+			cursors = append(cursors, *cursor)
+		}
+		if len(cursors) > 2 {
+			t.Fatalf("Unexpected request with cursor %s", *cursor)
+		}
+		// no cursor is returned, number of results should be used instead
+		return policyGroupMemberPage{count: 1, resultCount: &count}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"1", "2"}
+	if fmt.Sprintf("%v", cursors) != fmt.Sprintf("%v", expected) {
+		t.Errorf("Expected cursors %v, got %v", expected, cursors)
+	}
+
+	// empty page should stop listing regardless of result count
+	requests := 0
+	err = listPolicyGroupMemberPages(func(cursor *string) (policyGroupMemberPage, error) {
+		requests++
+		return policyGroupMemberPage{resultCount: &count}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 request, got %d", requests)
+	}
+}
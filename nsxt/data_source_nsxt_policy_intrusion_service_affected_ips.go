@@ -0,0 +1,78 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall/security/intrusion_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyIntrusionServiceAffectedIps() *schema.Resource {
+	s := getIdsEventFilterSchema()
+	s["ips"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Workload IPs on which intrusions were detected",
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceNsxtPolicyIntrusionServiceAffectedIpsRead,
+		Schema: s,
+	}
+}
+
+func listPolicyIntrusionServiceAffectedIps(connector *client.RestConnector, request model.PolicyIdsEventDataRequest) ([]string, error) {
+	client := intrusion_services.NewAffectedIpsClient(connector)
+
+	var results []string
+	var cursor *string
+	total := 0
+
+	for {
+		ips, err := client.Create(request, cursor, nil, nil, nil, nil)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, ips.Results...)
+		if total == 0 && ips.ResultCount != nil {
+			// first response
+			total = int(*ips.ResultCount)
+		}
+
+		cursor = ips.Cursor
+		if len(results) >= total {
+			return results, nil
+		}
+	}
+}
+
+func dataSourceNsxtPolicyIntrusionServiceAffectedIpsRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return dataSourceNotSupportedError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	request, err := getIdsEventDataRequestFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	ips, err := listPolicyIntrusionServiceAffectedIps(connector, request)
+	if err != nil {
+		return fmt.Errorf("Error while reading IDS affected IPs: %v", err)
+	}
+
+	d.Set("ips", ips)
+	d.SetId(newUUID())
+
+	return nil
+}
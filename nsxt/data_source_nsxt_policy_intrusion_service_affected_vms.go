@@ -0,0 +1,88 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall/security/intrusion_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyIntrusionServiceAffectedVms() *schema.Resource {
+	s := getIdsEventFilterSchema()
+	s["vms"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Names of VMs on which intrusions were detected",
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceNsxtPolicyIntrusionServiceAffectedVmsRead,
+		Schema: s,
+	}
+}
+
+func listPolicyIntrusionServiceAffectedVms(connector *client.RestConnector, request model.PolicyIdsEventDataRequest) ([]string, error) {
+	client := intrusion_services.NewAffectedVmsClient(connector)
+
+	return listPolicyIntrusionServiceAffectedVmPages(func(cursor *string) (model.PolicyIdsVmList, error) {
+		return client.Create(request, cursor, nil, nil, nil, nil)
+	})
+}
+
+func listPolicyIntrusionServiceAffectedVmPages(listFunc func(cursor *string) (model.PolicyIdsVmList, error)) ([]string, error) {
+	var results []string
+	var cursor *string
+	total := 0
+
+	for {
+		vms, err := listFunc(cursor)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, vms.Results...)
+		if len(vms.Results) == 0 {
+			// no more results
+			return results, nil
+		}
+		if total == 0 && vms.ResultCount != nil {
+			// first response
+			total = int(*vms.ResultCount)
+		}
+
+		cursor = vms.Cursor
+		if cursor == nil || len(results) >= total {
+			return results, nil
+		}
+	}
+}
+
+func dataSourceNsxtPolicyIntrusionServiceAffectedVmsRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return dataSourceNotSupportedError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	request, err := getIdsEventDataRequestFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	vms, err := listPolicyIntrusionServiceAffectedVms(connector, request)
+	if err != nil {
+		return fmt.Errorf("Error while reading IDS affected VMs: %v", err)
+	}
+
+	d.Set("vms", vms)
+	d.SetId(newUUID())
+
+	return nil
+}
@@ -0,0 +1,94 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func TestAccDataSourceNsxtPolicyIntrusionServiceAffectedVms_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_intrusion_service_affected_vms.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.1.0")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIntrusionServiceAffectedVmsReadTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "vms.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIntrusionServiceAffectedVmsReadTemplate() string {
+	return `
+data "nsxt_policy_intrusion_service_affected_vms" "test" {
+  start_time = "2022-01-01T00:00:00Z"
+}`
+}
+
+func TestListPolicyIntrusionServiceAffectedVmPages(t *testing.T) {
+	nextCursor := "2"
+	count := int64(5)
+	pages := map[string]model.PolicyIdsVmList{
+		"": {
+			Results:     []string{"vm-1", "vm-2"},
+			ResultCount: &count,
+			Cursor:      &nextCursor,
+		},
+		// short final page without cursor, result count not reached
+		"2": {
+			Results:     []string{"vm-3"},
+			ResultCount: &count,
+		},
+	}
+
+	calls := 0
+	vms, err := listPolicyIntrusionServiceAffectedVmPages(func(cursor *string) (model.PolicyIdsVmList, error) {
+		calls++
+		if calls > len(pages) {
+			t.Fatalf("Unexpected request for page %d", calls)
+		}
+		key := ""
+		if cursor != nil {
+			key = *cursor
+		}
+		return pages[key], nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"vm-1", "vm-2", "vm-3"}
+	if !reflect.DeepEqual(vms, expected) {
+		t.Errorf("Expected %v, got %v", expected, vms)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 requests, got %d", calls)
+	}
+
+	// empty page with cursor should stop listing as well
+	calls = 0
+	_, err = listPolicyIntrusionServiceAffectedVmPages(func(cursor *string) (model.PolicyIdsVmList, error) {
+		calls++
+		if calls > 1 {
+			t.Fatalf("Unexpected request for page %d", calls)
+		}
+		return model.PolicyIdsVmList{ResultCount: &count, Cursor: &nextCursor}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,242 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall/security/intrusion_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var idsEventSeverityValues = []string{
+	model.IdsProfileSeverity_SEVERITY_CRITICAL,
+	model.IdsProfileSeverity_SEVERITY_HIGH,
+	model.IdsProfileSeverity_SEVERITY_MEDIUM,
+	model.IdsProfileSeverity_SEVERITY_LOW,
+	model.IdsProfileSeverity_SEVERITY_SUSPICIOUS,
+}
+
+// Filter attributes shared by all IDS event data sources
+func getIdsEventFilterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"start_time": {
+			Type:         schema.TypeString,
+			Description:  "Start of time window in RFC3339 format",
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+		"end_time": {
+			Type:         schema.TypeString,
+			Description:  "End of time window in RFC3339 format",
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+		"policy_path": {
+			Type:         schema.TypeString,
+			Description:  "Path of IDS policy that detected the events",
+			Optional:     true,
+			ValidateFunc: validatePolicyPath(),
+		},
+		"severities": {
+			Type:        schema.TypeSet,
+			Description: "Severities of events to include",
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(idsEventSeverityValues, false),
+			},
+		},
+	}
+}
+
+func getIdsEventTimeFilter(d *schema.ResourceData, attrName string) (*model.FilterRequest, error) {
+	value := d.Get(attrName).(string)
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse %s: %v", attrName, err)
+	}
+
+	// NSX expects epoch milliseconds
+	epoch := strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10)
+	return &model.FilterRequest{
+		FieldNames: &attrName,
+		Value:      &epoch,
+	}, nil
+}
+
+func getIdsEventDataRequestFromSchema(d *schema.ResourceData) (model.PolicyIdsEventDataRequest, error) {
+	var filters []model.FilterRequest
+
+	for _, attrName := range []string{"start_time", "end_time"} {
+		filter, err := getIdsEventTimeFilter(d, attrName)
+		if err != nil {
+			return model.PolicyIdsEventDataRequest{}, err
+		}
+		if filter != nil {
+			filters = append(filters, *filter)
+		}
+	}
+
+	policyPath := d.Get("policy_path").(string)
+	if policyPath != "" {
+		fieldName := "policy_path"
+		filters = append(filters, model.FilterRequest{
+			FieldNames: &fieldName,
+			Value:      &policyPath,
+		})
+	}
+
+	severities := getStringListFromSchemaSet(d, "severities")
+	if len(severities) > 0 {
+		fieldName := "severity"
+		value := strings.Join(severities, ",")
+		filters = append(filters, model.FilterRequest{
+			FieldNames: &fieldName,
+			Value:      &value,
+		})
+	}
+
+	return model.PolicyIdsEventDataRequest{Filters: filters}, nil
+}
+
+func dataSourceNsxtPolicyIntrusionServiceEvents() *schema.Resource {
+	s := getIdsEventFilterSchema()
+	s["items"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "IDS events grouped by signature, ordered by count",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"signature_id": {
+					Type:        schema.TypeInt,
+					Description: "Signature ID",
+					Computed:    true,
+				},
+				"signature_name": {
+					Type:        schema.TypeString,
+					Description: "Signature name",
+					Computed:    true,
+				},
+				"severity": {
+					Type:        schema.TypeString,
+					Description: "Signature severity",
+					Computed:    true,
+				},
+				"count": {
+					Type:        schema.TypeInt,
+					Description: "Number of times this signature was detected",
+					Computed:    true,
+				},
+				"first_occurrence": {
+					Type:        schema.TypeInt,
+					Description: "First occurrence of the intrusion, in epoch milliseconds",
+					Computed:    true,
+				},
+				"is_ongoing": {
+					Type:        schema.TypeBool,
+					Description: "Whether the intrusion is ongoing",
+					Computed:    true,
+				},
+				"traffic_type": {
+					Type:        schema.TypeString,
+					Description: "Traffic type, GATEWAY or HOST",
+					Computed:    true,
+				},
+			},
+		},
+	}
+	s["counts"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Description: "Total count of events per severity",
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeInt,
+		},
+	}
+	s["total_count"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: "Total count of events",
+		Computed:    true,
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceNsxtPolicyIntrusionServiceEventsRead,
+		Schema: s,
+	}
+}
+
+func dataSourceNsxtPolicyIntrusionServiceEventsRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return dataSourceNotSupportedError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	request, err := getIdsEventDataRequestFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	client := intrusion_services.NewIdsEventsClient(connector)
+	result, err := client.Create(request)
+	if err != nil {
+		return fmt.Errorf("Error while reading IDS events: %v", err)
+	}
+
+	events := result.Results
+	sort.SliceStable(events, func(i, j int) bool {
+		var left, right int64
+		if events[i].Count != nil {
+			left = *events[i].Count
+		}
+		if events[j].Count != nil {
+			right = *events[j].Count
+		}
+		return left > right
+	})
+
+	counts := make(map[string]interface{})
+	for _, severity := range idsEventSeverityValues {
+		counts[severity] = 0
+	}
+	total := 0
+	var items []map[string]interface{}
+	for _, event := range events {
+		elem := make(map[string]interface{})
+		elem["signature_id"] = event.SignatureId
+		elem["signature_name"] = event.SignatureName
+		elem["severity"] = event.Severity
+		elem["count"] = event.Count
+		elem["first_occurrence"] = event.FirstOccurence
+		elem["is_ongoing"] = event.IsOngoing
+		elem["traffic_type"] = event.TrafficType
+		items = append(items, elem)
+
+		if event.Count != nil {
+			total += int(*event.Count)
+			if event.Severity != nil {
+				current, _ := counts[*event.Severity].(int)
+				counts[*event.Severity] = current + int(*event.Count)
+			}
+		}
+	}
+
+	d.Set("items", items)
+	d.Set("counts", counts)
+	d.Set("total_count", total)
+	d.SetId(newUUID())
+
+	return nil
+}
@@ -0,0 +1,41 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyIntrusionServiceEvents_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_intrusion_service_events.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.1.0")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIntrusionServiceEventsReadTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "items.#"),
+					resource.TestCheckResourceAttrSet(testResourceName, "total_count"),
+					resource.TestCheckResourceAttrSet(testResourceName, "counts.CRITICAL"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIntrusionServiceEventsReadTemplate() string {
+	return `
+data "nsxt_policy_intrusion_service_events" "test" {
+  start_time = "2022-01-01T00:00:00Z"
+  severities = ["CRITICAL", "HIGH"]
+}`
+}
@@ -0,0 +1,133 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall/security/intrusion_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyIntrusionServiceSummary() *schema.Resource {
+	s := getIdsEventFilterSchema()
+	s["items"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Summary of detected intrusions per signature",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"signature_id": {
+					Type:        schema.TypeInt,
+					Description: "Signature ID",
+					Computed:    true,
+				},
+				"rule_id": {
+					Type:        schema.TypeInt,
+					Description: "ID of IDS rule that detected the intrusion",
+					Computed:    true,
+				},
+				"total_count": {
+					Type:        schema.TypeInt,
+					Description: "Number of times this signature was detected",
+					Computed:    true,
+				},
+				"affected_vm_count": {
+					Type:        schema.TypeInt,
+					Description: "Count of VMs on which this signature was detected",
+					Computed:    true,
+				},
+				"affected_ip_count": {
+					Type:        schema.TypeInt,
+					Description: "Count of workload IPs on which this signature was detected",
+					Computed:    true,
+				},
+				"first_occurrence": {
+					Type:        schema.TypeInt,
+					Description: "First occurrence of the intrusion, in epoch milliseconds",
+					Computed:    true,
+				},
+				"latest_occurrence": {
+					Type:        schema.TypeInt,
+					Description: "Latest occurrence of the intrusion, in epoch milliseconds",
+					Computed:    true,
+				},
+				"is_ongoing": {
+					Type:        schema.TypeBool,
+					Description: "Whether the intrusion is ongoing",
+					Computed:    true,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceNsxtPolicyIntrusionServiceSummaryRead,
+		Schema: s,
+	}
+}
+
+func listPolicyIntrusionServiceSummary(connector *client.RestConnector, request model.PolicyIdsEventDataRequest) ([]model.PolicyIdsEventsSummary, error) {
+	client := intrusion_services.NewIdsSummaryClient(connector)
+
+	var results []model.PolicyIdsEventsSummary
+	var cursor *string
+	total := 0
+
+	for {
+		summary, err := client.Create(request, cursor, nil, nil, nil, nil)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, summary.Results...)
+		if total == 0 && summary.ResultCount != nil {
+			// first response
+			total = int(*summary.ResultCount)
+		}
+
+		cursor = summary.Cursor
+		if len(results) >= total {
+			return results, nil
+		}
+	}
+}
+
+func dataSourceNsxtPolicyIntrusionServiceSummaryRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return dataSourceNotSupportedError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	request, err := getIdsEventDataRequestFromSchema(d)
+	if err != nil {
+		return err
+	}
+
+	results, err := listPolicyIntrusionServiceSummary(connector, request)
+	if err != nil {
+		return fmt.Errorf("Error while reading IDS summary: %v", err)
+	}
+
+	var items []map[string]interface{}
+	for _, obj := range results {
+		elem := make(map[string]interface{})
+		elem["signature_id"] = obj.SignatureId
+		elem["rule_id"] = obj.RuleId
+		elem["total_count"] = obj.TotalCount
+		elem["affected_vm_count"] = obj.AffectedVmCount
+		elem["affected_ip_count"] = obj.AffectedIpCount
+		elem["first_occurrence"] = obj.FirstOccurence
+		elem["latest_occurrence"] = obj.LatestOccurence
+		elem["is_ongoing"] = obj.IsOngoing
+		items = append(items, elem)
+	}
+
+	d.Set("items", items)
+	d.SetId(newUUID())
+
+	return nil
+}
@@ -0,0 +1,38 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyIntrusionServiceSummary_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_intrusion_service_summary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.1.0")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIntrusionServiceSummaryReadTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "items.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIntrusionServiceSummaryReadTemplate() string {
+	return `
+data "nsxt_policy_intrusion_service_summary" "test" {
+  start_time = "2022-01-01T00:00:00Z"
+}`
+}
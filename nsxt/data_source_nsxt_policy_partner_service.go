@@ -0,0 +1,175 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyPartnerService() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyPartnerServiceRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":           getDataSourceIDSchema(),
+			"display_name": getDataSourceDisplayNameSchema(),
+			"description":  getDataSourceDescriptionSchema(),
+			"attachment_points": {
+				Type:        schema.TypeList,
+				Description: "Attachment points supported by this service",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"functionalities": {
+				Type:        schema.TypeList,
+				Description: "Functionalities provided by this service",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"implementations": {
+				Type:        schema.TypeList,
+				Description: "Implementation types of this service",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"transports": {
+				Type:        schema.TypeList,
+				Description: "Transport types supported by this service",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"on_failure_policy": {
+				Type:        schema.TypeString,
+				Description: "Failure policy of the service",
+				Computed:    true,
+			},
+			"vendor_id": {
+				Type:        schema.TypeString,
+				Description: "Identifier of the partner vendor",
+				Computed:    true,
+			},
+			"service_manager_id": {
+				Type:        schema.TypeString,
+				Description: "Identifier of the partner service manager",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func listPolicyPartnerServices(connector *client.RestConnector) ([]model.ServiceDefinition, error) {
+	client := infra.NewPartnerServicesClient(connector)
+
+	var results []model.ServiceDefinition
+	var cursor *string
+	total := 0
+
+	for {
+		services, err := client.List(cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, services.Results...)
+		if total == 0 && services.ResultCount != nil {
+			// first response
+			total = int(*services.ResultCount)
+		}
+
+		cursor = services.Cursor
+		if len(results) >= total {
+			return results, nil
+		}
+	}
+}
+
+func dataSourceNsxtPolicyPartnerServiceRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return dataSourceNotSupportedError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	objID := d.Get("id").(string)
+	objName := d.Get("display_name").(string)
+	if objID == "" && objName == "" {
+		return fmt.Errorf("Partner Service id or display name must be specified")
+	}
+
+	// Partner services are addressed by name in the API, hence the lookup
+	// goes over the full list for both id and display name
+	objList, err := listPolicyPartnerServices(connector)
+	if err != nil {
+		return fmt.Errorf("Error while reading Partner Services: %v", err)
+	}
+
+	var obj model.ServiceDefinition
+	if objID != "" {
+		found := false
+		for _, objInList := range objList {
+			if objInList.Id != nil && *objInList.Id == objID {
+				obj = objInList
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("Partner Service with ID %s was not found", objID)
+		}
+	} else {
+		// go over the list to find the correct one (prefer a perfect match. If not - prefix match)
+		var perfectMatch []model.ServiceDefinition
+		var prefixMatch []model.ServiceDefinition
+		for _, objInList := range objList {
+			if objInList.DisplayName == nil {
+				continue
+			}
+			if strings.HasPrefix(*objInList.DisplayName, objName) {
+				prefixMatch = append(prefixMatch, objInList)
+			}
+			if *objInList.DisplayName == objName {
+				perfectMatch = append(perfectMatch, objInList)
+			}
+		}
+		if len(perfectMatch) > 0 {
+			if len(perfectMatch) > 1 {
+				return fmt.Errorf("Found multiple Partner Services with name '%s'", objName)
+			}
+			obj = perfectMatch[0]
+		} else if len(prefixMatch) > 0 {
+			if len(prefixMatch) > 1 {
+				return fmt.Errorf("Found multiple Partner Services with name starting with '%s'", objName)
+			}
+			obj = prefixMatch[0]
+		} else {
+			return fmt.Errorf("Partner Service with name '%s' was not found", objName)
+		}
+	}
+
+	d.SetId(*obj.Id)
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	d.Set("attachment_points", obj.AttachmentPoint)
+	d.Set("functionalities", obj.Functionalities)
+	d.Set("implementations", obj.Implementations)
+	d.Set("transports", obj.Transports)
+	d.Set("on_failure_policy", obj.OnFailurePolicy)
+	d.Set("vendor_id", obj.VendorId)
+	d.Set("service_manager_id", obj.ServiceManagerId)
+
+	return nil
+}
@@ -0,0 +1,43 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyPartnerService_basic(t *testing.T) {
+	name := getTestPartnerServiceName()
+	testResourceName := "data.nsxt_policy_partner_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_PARTNER_SERVICE_NAME")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyPartnerServiceReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "functionalities.#"),
+					resource.TestCheckResourceAttrSet(testResourceName, "implementations.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyPartnerServiceReadTemplate(name string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_partner_service" "test" {
+  display_name = "%s"
+}`, name)
+}
@@ -44,6 +44,7 @@ func dataSourceNsxtPolicyRealizationInfo() *schema.Resource {
 				Description: "The ID of the realized resource",
 				Computed:    true,
 			},
+			"realization_errors": getRealizationErrorsSchema(),
 			"site_path": {
 				Type:         schema.TypeString,
 				Description:  "Path of the site this resource belongs to",
@@ -157,9 +158,16 @@ func dataSourceNsxtPolicyRealizationInfoRead(d *schema.ResourceData, m interface
 		MinTimeout: 1 * time.Second,
 		Delay:      time.Duration(delay) * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	result, err := stateConf.WaitForState()
 	if err != nil {
 		return fmt.Errorf("Failed to get realization information for %s: %v", path, err)
 	}
-	return nil
+
+	var entities []model.GenericPolicyRealizedResource
+	for _, objInList := range result.(model.GenericPolicyRealizedResourceListResult).Results {
+		if entityType == "" || (objInList.EntityType != nil && *objInList.EntityType == entityType) {
+			entities = append(entities, objInList)
+		}
+	}
+	return d.Set("realization_errors", getPolicyRealizationErrors(entities))
 }
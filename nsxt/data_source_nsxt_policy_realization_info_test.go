@@ -36,6 +36,7 @@ func TestAccDataSourceNsxtPolicyRealizationInfo_tier1DataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet(testResourceName, "entity_type"),
 					resource.TestCheckResourceAttrSet(testResourceName, "realized_id"),
 					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttr(testResourceName, "realization_errors.#", "0"),
 				),
 			},
 		},
@@ -138,6 +139,7 @@ func TestAccDataSourceNsxtPolicyRealizationInfo_gmServiceDataSource(t *testing.T
 					resource.TestCheckResourceAttrSet(testResourceName, "entity_type"),
 					resource.TestCheckResourceAttrSet(testResourceName, "realized_id"),
 					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttr(testResourceName, "realization_errors.#", "0"),
 					resource.TestCheckResourceAttrSet(testResourceName, "site_path"),
 				),
 			},
@@ -0,0 +1,162 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/service_references"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyServiceProfiles() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyServiceProfilesRead,
+
+		Schema: map[string]*schema.Schema{
+			"partner_service_name": {
+				Type:        schema.TypeString,
+				Description: "Name of partner service to list profiles for. If not set, profiles of all partner services are listed",
+				Optional:    true,
+			},
+			"items": {
+				Type:        schema.TypeList,
+				Description: "Service profiles of registered partner services",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "ID of the service profile",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Display name of the service profile",
+							Computed:    true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "Description of the service profile",
+							Computed:    true,
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the service profile",
+							Computed:    true,
+						},
+						"partner_service_name": {
+							Type:        schema.TypeString,
+							Description: "Name of partner service this profile belongs to",
+							Computed:    true,
+						},
+						"vendor_template_name": {
+							Type:        schema.TypeString,
+							Description: "Name of vendor template this profile is based on",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func listPolicyServiceReferences(connector *client.RestConnector) ([]model.ServiceReference, error) {
+	client := infra.NewServiceReferencesClient(connector)
+
+	var results []model.ServiceReference
+	var cursor *string
+	total := 0
+
+	for {
+		references, err := client.List(cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, references.Results...)
+		if total == 0 && references.ResultCount != nil {
+			// first response
+			total = int(*references.ResultCount)
+		}
+
+		cursor = references.Cursor
+		if len(results) >= total {
+			return results, nil
+		}
+	}
+}
+
+func listPolicyServiceProfiles(connector *client.RestConnector, serviceReferenceID string) ([]model.PolicyServiceProfile, error) {
+	client := service_references.NewServiceProfilesClient(connector)
+
+	var results []model.PolicyServiceProfile
+	var cursor *string
+	total := 0
+
+	for {
+		profiles, err := client.List(serviceReferenceID, cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, profiles.Results...)
+		if total == 0 && profiles.ResultCount != nil {
+			// first response
+			total = int(*profiles.ResultCount)
+		}
+
+		cursor = profiles.Cursor
+		if len(results) >= total {
+			return results, nil
+		}
+	}
+}
+
+func dataSourceNsxtPolicyServiceProfilesRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return dataSourceNotSupportedError()
+	}
+
+	connector := getPolicyConnector(m)
+	serviceName := d.Get("partner_service_name").(string)
+
+	references, err := listPolicyServiceReferences(connector)
+	if err != nil {
+		return fmt.Errorf("Error while reading Service References: %v", err)
+	}
+
+	var items []map[string]interface{}
+	for _, reference := range references {
+		if reference.Id == nil {
+			continue
+		}
+		if serviceName != "" && (reference.PartnerServiceName == nil || *reference.PartnerServiceName != serviceName) {
+			continue
+		}
+
+		profiles, err := listPolicyServiceProfiles(connector, *reference.Id)
+		if err != nil {
+			return fmt.Errorf("Error while reading Service Profiles for Service Reference %s: %v", *reference.Id, err)
+		}
+
+		for _, profile := range profiles {
+			elem := make(map[string]interface{})
+			elem["id"] = profile.Id
+			elem["display_name"] = profile.DisplayName
+			elem["description"] = profile.Description
+			elem["path"] = profile.Path
+			elem["partner_service_name"] = reference.PartnerServiceName
+			elem["vendor_template_name"] = profile.VendorTemplateName
+			items = append(items, elem)
+		}
+	}
+
+	d.Set("items", items)
+	d.SetId(newUUID())
+
+	return nil
+}
@@ -0,0 +1,42 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyServiceProfiles_basic(t *testing.T) {
+	serviceName := getTestPartnerServiceName()
+	testResourceName := "data.nsxt_policy_service_profiles.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_PARTNER_SERVICE_NAME")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyServiceProfilesReadTemplate(serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "items.#"),
+					resource.TestCheckResourceAttr(testResourceName, "items.0.partner_service_name", serviceName),
+					resource.TestCheckResourceAttrSet(testResourceName, "items.0.path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyServiceProfilesReadTemplate(serviceName string) string {
+	return fmt.Sprintf(`
+data "nsxt_policy_service_profiles" "test" {
+  partner_service_name = "%s"
+}`, serviceName)
+}
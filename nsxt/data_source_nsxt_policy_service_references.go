@@ -0,0 +1,97 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyServiceReferences() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyServiceReferencesRead,
+
+		Schema: map[string]*schema.Schema{
+			"id":           getDataSourceIDSchema(),
+			"service_path": getPolicyPathSchema(true, false, "Policy path of the service"),
+			"items": {
+				Type:        schema.TypeList,
+				Description: "Policy objects that reference the service",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "ID of the referencing object",
+							Computed:    true,
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Display name of the referencing object",
+							Computed:    true,
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the referencing object",
+							Computed:    true,
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Description: "Resource type of the referencing object",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicyServiceReferencesRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	servicePath := d.Get("service_path").(string)
+
+	// Rules reference services directly, while other services may include
+	// this service via nested service entry
+	escapedPath := strings.Replace(escapeSpecialCharacters(servicePath), "/", "\\/", -1)
+	query := fmt.Sprintf("(services:%s OR service_entries.nested_service_path:%s) AND marked_for_delete:false", escapedPath, escapedPath)
+
+	var resultValues []*data.StructValue
+	var err error
+	if isPolicyGlobalManager(m) {
+		resultValues, err = searchGMPolicyResources(connector, query)
+	} else {
+		resultValues, err = searchLMPolicyResources(connector, query)
+	}
+	if err != nil {
+		return fmt.Errorf("Error while searching references to service %s: %v", servicePath, err)
+	}
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+	var items []map[string]interface{}
+	for _, result := range resultValues {
+		dataValue, errors := converter.ConvertToGolang(result, model.PolicyResourceBindingType())
+		if len(errors) > 0 {
+			return errors[0]
+		}
+		policyResource := dataValue.(model.PolicyResource)
+		elem := make(map[string]interface{})
+		elem["id"] = policyResource.Id
+		elem["display_name"] = policyResource.DisplayName
+		elem["path"] = policyResource.Path
+		elem["resource_type"] = policyResource.ResourceType
+		items = append(items, elem)
+	}
+
+	d.Set("items", items)
+	d.SetId(newUUID())
+
+	return nil
+}
@@ -0,0 +1,73 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyServiceReferences_basic(t *testing.T) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_service_references.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyServiceReferencesReadTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "items.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(testResourceName, "items.*.path", "nsxt_policy_service.composite", "path"),
+					resource.TestCheckTypeSetElemNestedAttrs(testResourceName, "items.*", map[string]string{
+						"display_name":  "rule1",
+						"resource_type": "Rule",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyServiceReferencesReadTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_service" "test" {
+  display_name = "%s"
+
+  l4_port_set_entry {
+    protocol          = "TCP"
+    destination_ports = ["8080"]
+  }
+}
+
+resource "nsxt_policy_service" "composite" {
+  display_name = "%s-composite"
+
+  nested_service_entry {
+    nested_service_path = nsxt_policy_service.test.path
+  }
+}
+
+resource "nsxt_policy_security_policy" "test" {
+  display_name = "%s"
+  category     = "Application"
+
+  rule {
+    display_name = "rule1"
+    services     = [nsxt_policy_service.test.path]
+    action       = "ALLOW"
+  }
+}
+
+data "nsxt_policy_service_references" "test" {
+  service_path = nsxt_policy_service.test.path
+  depends_on   = [nsxt_policy_service.composite, nsxt_policy_security_policy.test]
+}`, name, name, name)
+}
@@ -0,0 +1,133 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyTLSInspectionState() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyTLSInspectionStateRead,
+
+		Schema: map[string]*schema.Schema{
+			"gateway_path": getPolicyPathSchema(true, false, "Policy path of Tier-1 gateway"),
+			"fqdn": {
+				Type:        schema.TypeString,
+				Description: "Only return state for this domain name",
+				Optional:    true,
+			},
+			"failed_domains_only": {
+				Type:        schema.TypeBool,
+				Description: "Only return domains for which TLS inspection failed",
+				Optional:    true,
+				Default:     false,
+			},
+			"items": {
+				Type:        schema.TypeList,
+				Description: "TLS inspection state per domain",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fqdn": {
+							Type:        schema.TypeString,
+							Description: "Fully qualified domain name",
+							Computed:    true,
+						},
+						"inspection_action": {
+							Type:        schema.TypeString,
+							Description: "Action taken for this domain",
+							Computed:    true,
+						},
+						"failure_reasons": {
+							Type:        schema.TypeList,
+							Description: "TLS inspection failure reasons",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"scope": {
+							Type:        schema.TypeString,
+							Description: "Policy path of gateway reporting this state",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func listPolicyTLSInspectionState(connector *client.RestConnector, gwID string, fqdn *string, failedOnly *bool) ([]model.TlsStateObject, error) {
+	client := tier_1s.NewTlsInspectionStateClient(connector)
+
+	var results []model.TlsStateObject
+	var cursor *string
+	total := 0
+
+	for {
+		states, err := client.List(gwID, cursor, failedOnly, fqdn, nil, nil, nil, nil)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, states.Results...)
+		if total == 0 && states.ResultCount != nil {
+			// first response
+			total = int(*states.ResultCount)
+		}
+
+		cursor = states.Cursor
+		if len(results) >= total {
+			return results, nil
+		}
+	}
+}
+
+func dataSourceNsxtPolicyTLSInspectionStateRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return dataSourceNotSupportedError()
+	}
+
+	gwPath := d.Get("gateway_path").(string)
+	gwID, err := parseTLSInspectionGatewayPath(gwPath)
+	if err != nil {
+		return err
+	}
+
+	var fqdn *string
+	if value := d.Get("fqdn").(string); value != "" {
+		fqdn = &value
+	}
+	var failedOnly *bool
+	if d.Get("failed_domains_only").(bool) {
+		value := true
+		failedOnly = &value
+	}
+
+	states, err := listPolicyTLSInspectionState(getPolicyConnector(m), gwID, fqdn, failedOnly)
+	if err != nil {
+		return fmt.Errorf("Error while reading TLS inspection state for gateway %s: %v", gwPath, err)
+	}
+
+	var items []map[string]interface{}
+	for _, state := range states {
+		elem := make(map[string]interface{})
+		elem["fqdn"] = state.Fqdn
+		elem["inspection_action"] = state.InspectionAction
+		elem["failure_reasons"] = state.FailureReasons
+		elem["scope"] = state.Scope
+		items = append(items, elem)
+	}
+
+	d.SetId(newUUID())
+	d.Set("items", items)
+
+	return nil
+}
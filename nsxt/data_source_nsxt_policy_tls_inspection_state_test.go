@@ -0,0 +1,41 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyTLSInspectionState_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_tls_inspection_state.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTLSInspectionStateReadTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttr(testResourceName, "failed_domains_only", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyTLSInspectionStateReadTemplate() string {
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) +
+		testAccNsxtPolicyTier1WithEdgeClusterTemplate("test", false) + `
+
+data "nsxt_policy_tls_inspection_state" "test" {
+  gateway_path        = nsxt_policy_tier1_gateway.test.path
+  failed_domains_only = true
+}`
+}
@@ -0,0 +1,109 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyURLCategories() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyURLCategoriesRead,
+
+		Schema: map[string]*schema.Schema{
+			"items": {
+				Type:        schema.TypeList,
+				Description: "URL categories available for URL filtering",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"category_id": {
+							Type:        schema.TypeInt,
+							Description: "Numeric identifier of the category",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the category",
+							Computed:    true,
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the category",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Description: "Names of URL categories available for URL filtering",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func listPolicyURLCategories(connector *client.RestConnector) ([]model.PolicyUrlCategory, error) {
+	client := infra.NewUrlCategoriesClient(connector)
+
+	var results []model.PolicyUrlCategory
+	var cursor *string
+	total := 0
+
+	for {
+		categories, err := client.List(cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, categories.Results...)
+		if total == 0 && categories.ResultCount != nil {
+			// first response
+			total = int(*categories.ResultCount)
+		}
+
+		cursor = categories.Cursor
+		if len(results) >= total {
+			return results, nil
+		}
+	}
+}
+
+func dataSourceNsxtPolicyURLCategoriesRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return dataSourceNotSupportedError()
+	}
+
+	categories, err := listPolicyURLCategories(getPolicyConnector(m))
+	if err != nil {
+		return fmt.Errorf("Error while reading URL categories: %v", err)
+	}
+
+	var items []map[string]interface{}
+	var names []string
+	for _, category := range categories {
+		elem := make(map[string]interface{})
+		elem["category_id"] = category.CategoryId
+		elem["name"] = category.CategoryName
+		elem["path"] = category.Path
+		items = append(items, elem)
+		if category.CategoryName != nil {
+			names = append(names, *category.CategoryName)
+		}
+	}
+
+	d.SetId(newUUID())
+	d.Set("items", items)
+	d.Set("names", names)
+
+	return nil
+}
@@ -0,0 +1,108 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyURLReputationSeverities() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNsxtPolicyURLReputationSeveritiesRead,
+
+		Schema: map[string]*schema.Schema{
+			"items": {
+				Type:        schema.TypeList,
+				Description: "URL reputation severities available for URL filtering",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"reputation_severity_id": {
+							Type:        schema.TypeInt,
+							Description: "Numeric identifier of the severity",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the severity",
+							Computed:    true,
+						},
+						"min_reputation": {
+							Type:        schema.TypeInt,
+							Description: "Minimum reputation score of this severity",
+							Computed:    true,
+						},
+						"max_reputation": {
+							Type:        schema.TypeInt,
+							Description: "Maximum reputation score of this severity",
+							Computed:    true,
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Description: "Policy path of the severity",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func listPolicyURLReputationSeverities(connector *client.RestConnector) ([]model.PolicyUrlReputationSeverity, error) {
+	client := infra.NewUrlReputationSeveritiesClient(connector)
+
+	var results []model.PolicyUrlReputationSeverity
+	var cursor *string
+	total := 0
+
+	for {
+		severities, err := client.List(cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, severities.Results...)
+		if total == 0 && severities.ResultCount != nil {
+			// first response
+			total = int(*severities.ResultCount)
+		}
+
+		cursor = severities.Cursor
+		if len(results) >= total {
+			return results, nil
+		}
+	}
+}
+
+func dataSourceNsxtPolicyURLReputationSeveritiesRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return dataSourceNotSupportedError()
+	}
+
+	severities, err := listPolicyURLReputationSeverities(getPolicyConnector(m))
+	if err != nil {
+		return fmt.Errorf("Error while reading URL reputation severities: %v", err)
+	}
+
+	var items []map[string]interface{}
+	for _, severity := range severities {
+		elem := make(map[string]interface{})
+		elem["reputation_severity_id"] = severity.ReputationSeverityId
+		elem["name"] = severity.Name
+		elem["min_reputation"] = severity.MinReputation
+		elem["max_reputation"] = severity.MaxReputation
+		elem["path"] = severity.Path
+		items = append(items, elem)
+	}
+
+	d.SetId(newUUID())
+	d.Set("items", items)
+
+	return nil
+}
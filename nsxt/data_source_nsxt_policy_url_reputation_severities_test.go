@@ -0,0 +1,37 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyURLReputationSeverities_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_url_reputation_severities.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyURLReputationSeveritiesReadTemplate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "items.#"),
+					resource.TestCheckResourceAttrSet(testResourceName, "items.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyURLReputationSeveritiesReadTemplate() string {
+	return `
+data "nsxt_policy_url_reputation_severities" "test" {
+}`
+}
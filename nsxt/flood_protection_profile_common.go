@@ -0,0 +1,288 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func getFloodProtectionLimitSchema(description string, maxValue int) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Description:  description,
+		Optional:     true,
+		ValidateFunc: validation.IntBetween(1, maxValue),
+	}
+}
+
+func getFloodProtectionProfileSchema(isGateway bool) map[string]*schema.Schema {
+	result := map[string]*schema.Schema{
+		"nsx_id":                   getNsxIDSchema(),
+		"path":                     getPathSchema(),
+		"display_name":             getDisplayNameSchema(),
+		"description":              getDescriptionSchema(),
+		"revision":                 getRevisionSchema(),
+		"tag":                      getTagsSchema(),
+		"icmp_active_flow_limit":   getFloodProtectionLimitSchema("Active ICMP connections limit", 1000000),
+		"other_active_conn_limit":  getFloodProtectionLimitSchema("Limit for active connections other than UDP, ICMP and half open TCP", 1000000),
+		"tcp_half_open_conn_limit": getFloodProtectionLimitSchema("Half open TCP connections limit", 1000000),
+		"udp_active_flow_limit":    getFloodProtectionLimitSchema("Active UDP connections limit", 1000000),
+	}
+
+	if isGateway {
+		result["nat_active_conn_limit"] = getFloodProtectionLimitSchema("Active NAT connections limit", math.MaxInt32)
+	} else {
+		result["enable_rst_spoofing"] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Description: "Enable RST spoofing for half open TCP connections",
+			Optional:    true,
+			Default:     false,
+		}
+		result["enable_syncache"] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Description: "Enable SYN cache",
+			Optional:    true,
+			Default:     false,
+		}
+	}
+
+	return result
+}
+
+func getFloodProtectionProfileLimit(d *schema.ResourceData, key string) *int64 {
+	if value, ok := d.GetOk(key); ok {
+		limit := int64(value.(int))
+		return &limit
+	}
+
+	return nil
+}
+
+func getFloodProtectionProfileBindingType(isGateway bool) bindings.BindingType {
+	if isGateway {
+		return model.GatewayFloodProtectionProfileBindingType()
+	}
+	return model.DistributedFloodProtectionProfileBindingType()
+}
+
+func policyFloodProtectionProfileSchemaToStructValue(d *schema.ResourceData, isGateway bool) (*data.StructValue, error) {
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	icmpActiveFlowLimit := getFloodProtectionProfileLimit(d, "icmp_active_flow_limit")
+	otherActiveConnLimit := getFloodProtectionProfileLimit(d, "other_active_conn_limit")
+	tcpHalfOpenConnLimit := getFloodProtectionProfileLimit(d, "tcp_half_open_conn_limit")
+	udpActiveFlowLimit := getFloodProtectionProfileLimit(d, "udp_active_flow_limit")
+	var revision *int64
+	if len(d.Id()) > 0 {
+		// This is update flow
+		value := int64(d.Get("revision").(int))
+		revision = &value
+	}
+
+	var obj interface{}
+	if isGateway {
+		obj = model.GatewayFloodProtectionProfile{
+			DisplayName:          &displayName,
+			Description:          &description,
+			Tags:                 tags,
+			Revision:             revision,
+			ResourceType:         model.FloodProtectionProfile_RESOURCE_TYPE_GATEWAYFLOODPROTECTIONPROFILE,
+			IcmpActiveFlowLimit:  icmpActiveFlowLimit,
+			OtherActiveConnLimit: otherActiveConnLimit,
+			TcpHalfOpenConnLimit: tcpHalfOpenConnLimit,
+			UdpActiveFlowLimit:   udpActiveFlowLimit,
+			NatActiveConnLimit:   getFloodProtectionProfileLimit(d, "nat_active_conn_limit"),
+		}
+	} else {
+		enableRstSpoofing := d.Get("enable_rst_spoofing").(bool)
+		enableSyncache := d.Get("enable_syncache").(bool)
+		obj = model.DistributedFloodProtectionProfile{
+			DisplayName:          &displayName,
+			Description:          &description,
+			Tags:                 tags,
+			Revision:             revision,
+			ResourceType:         model.FloodProtectionProfile_RESOURCE_TYPE_DISTRIBUTEDFLOODPROTECTIONPROFILE,
+			IcmpActiveFlowLimit:  icmpActiveFlowLimit,
+			OtherActiveConnLimit: otherActiveConnLimit,
+			TcpHalfOpenConnLimit: tcpHalfOpenConnLimit,
+			UdpActiveFlowLimit:   udpActiveFlowLimit,
+			EnableRstSpoofing:    &enableRstSpoofing,
+			EnableSyncache:       &enableSyncache,
+		}
+	}
+
+	dataValue, errs := converter.ConvertToVapi(obj, getFloodProtectionProfileBindingType(isGateway))
+	if errs != nil {
+		return nil, fmt.Errorf("Error converting Flood Protection Profile: %v", errs[0])
+	}
+
+	return dataValue.(*data.StructValue), nil
+}
+
+func resourceNsxtPolicyFloodProtectionProfileExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	var err error
+	if isGlobalManager {
+		client := gm_infra.NewFloodProtectionProfilesClient(connector)
+		_, err = client.Get(id)
+	} else {
+		client := infra.NewFloodProtectionProfilesClient(connector)
+		_, err = client.Get(id)
+	}
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Flood Protection Profile", err)
+}
+
+func policyFloodProtectionProfilePatch(d *schema.ResourceData, m interface{}, id string, isGateway bool) error {
+	connector := getPolicyConnector(m)
+	dataValue, err := policyFloodProtectionProfileSchemaToStructValue(d, isGateway)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Patching Flood Protection Profile with ID %s", id)
+	boolFalse := false
+	if isPolicyGlobalManager(m) {
+		return gm_infra.NewFloodProtectionProfilesClient(connector).Patch(id, dataValue, &boolFalse)
+	}
+	return infra.NewFloodProtectionProfilesClient(connector).Patch(id, dataValue, &boolFalse)
+}
+
+func resourceNsxtPolicyFloodProtectionProfileCreate(d *schema.ResourceData, m interface{}, isGateway bool) error {
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyFloodProtectionProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = policyFloodProtectionProfilePatch(d, m, id, isGateway)
+	if err != nil {
+		return handleCreateError("Flood Protection Profile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyFloodProtectionProfileRead(d, m, isGateway)
+}
+
+func resourceNsxtPolicyFloodProtectionProfileRead(d *schema.ResourceData, m interface{}, isGateway bool) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Flood Protection Profile ID")
+	}
+
+	var dataValue *data.StructValue
+	var err error
+	if isPolicyGlobalManager(m) {
+		dataValue, err = gm_infra.NewFloodProtectionProfilesClient(connector).Get(id)
+	} else {
+		dataValue, err = infra.NewFloodProtectionProfilesClient(connector).Get(id)
+	}
+	if err != nil {
+		return handleReadError(d, "Flood Protection Profile", id, err)
+	}
+
+	rawObj, errs := converter.ConvertToGolang(dataValue, getFloodProtectionProfileBindingType(isGateway))
+	if len(errs) > 0 {
+		return fmt.Errorf("Error converting Flood Protection Profile %s: %v", id, errs[0])
+	}
+
+	if isGateway {
+		obj := rawObj.(model.GatewayFloodProtectionProfile)
+		if obj.ResourceType != model.FloodProtectionProfile_RESOURCE_TYPE_GATEWAYFLOODPROTECTIONPROFILE {
+			return fmt.Errorf("Flood Protection Profile %s is of type %s, expected gateway profile", id, obj.ResourceType)
+		}
+		d.Set("display_name", obj.DisplayName)
+		d.Set("description", obj.Description)
+		setPolicyTagsInSchema(d, obj.Tags)
+		d.Set("path", obj.Path)
+		d.Set("revision", obj.Revision)
+		d.Set("icmp_active_flow_limit", obj.IcmpActiveFlowLimit)
+		d.Set("other_active_conn_limit", obj.OtherActiveConnLimit)
+		d.Set("tcp_half_open_conn_limit", obj.TcpHalfOpenConnLimit)
+		d.Set("udp_active_flow_limit", obj.UdpActiveFlowLimit)
+		d.Set("nat_active_conn_limit", obj.NatActiveConnLimit)
+	} else {
+		obj := rawObj.(model.DistributedFloodProtectionProfile)
+		if obj.ResourceType != model.FloodProtectionProfile_RESOURCE_TYPE_DISTRIBUTEDFLOODPROTECTIONPROFILE {
+			return fmt.Errorf("Flood Protection Profile %s is of type %s, expected distributed profile", id, obj.ResourceType)
+		}
+		d.Set("display_name", obj.DisplayName)
+		d.Set("description", obj.Description)
+		setPolicyTagsInSchema(d, obj.Tags)
+		d.Set("path", obj.Path)
+		d.Set("revision", obj.Revision)
+		d.Set("icmp_active_flow_limit", obj.IcmpActiveFlowLimit)
+		d.Set("other_active_conn_limit", obj.OtherActiveConnLimit)
+		d.Set("tcp_half_open_conn_limit", obj.TcpHalfOpenConnLimit)
+		d.Set("udp_active_flow_limit", obj.UdpActiveFlowLimit)
+		d.Set("enable_rst_spoofing", obj.EnableRstSpoofing)
+		d.Set("enable_syncache", obj.EnableSyncache)
+	}
+	d.Set("nsx_id", id)
+
+	return nil
+}
+
+func resourceNsxtPolicyFloodProtectionProfileUpdate(d *schema.ResourceData, m interface{}, isGateway bool) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Flood Protection Profile ID")
+	}
+
+	err := policyFloodProtectionProfilePatch(d, m, id, isGateway)
+	if err != nil {
+		return handleUpdateError("Flood Protection Profile", id, err)
+	}
+
+	return resourceNsxtPolicyFloodProtectionProfileRead(d, m, isGateway)
+}
+
+func resourceNsxtPolicyFloodProtectionProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Flood Protection Profile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	var err error
+	boolFalse := false
+	if isPolicyGlobalManager(m) {
+		err = gm_infra.NewFloodProtectionProfilesClient(connector).Delete(id, &boolFalse)
+	} else {
+		err = infra.NewFloodProtectionProfilesClient(connector).Delete(id, &boolFalse)
+	}
+
+	if err != nil {
+		return handleDeleteError("Flood Protection Profile", id, err)
+	}
+
+	return nil
+}
@@ -338,109 +338,122 @@ func getPolicySecurityPolicySchema(isIds bool) map[string]*schema.Schema {
 			Optional:    true,
 			Computed:    true,
 		},
-		"rule": getSecurityPolicyAndGatewayRulesSchema(false, isIds),
+		"schedule_path": getPolicyPathSchema(false, false, "Path of firewall schedule that limits the time during which rules of this policy are enforced"),
+		"rule":          getSecurityPolicyAndGatewayRulesSchema(false, isIds),
 	}
 
 	if isIds {
 		delete(result, "category")
 		delete(result, "scope")
 		delete(result, "tcp_strict")
+		delete(result, "schedule_path")
 	}
 
 	return result
 }
 
+func getPolicyRuleElemFromModel(rule model.Rule) map[string]interface{} {
+	elem := make(map[string]interface{})
+	elem["display_name"] = rule.DisplayName
+	elem["description"] = rule.Description
+	elem["notes"] = rule.Notes
+	elem["logged"] = rule.Logged
+	elem["log_label"] = rule.Tag
+	elem["action"] = rule.Action
+	elem["destinations_excluded"] = rule.DestinationsExcluded
+	elem["sources_excluded"] = rule.SourcesExcluded
+	elem["ip_version"] = rule.IpProtocol
+	elem["direction"] = rule.Direction
+	elem["disabled"] = rule.Disabled
+	elem["revision"] = rule.Revision
+	setPathListInMap(elem, "source_groups", rule.SourceGroups)
+	setPathListInMap(elem, "destination_groups", rule.DestinationGroups)
+	setPathListInMap(elem, "profiles", rule.Profiles)
+	setPathListInMap(elem, "services", rule.Services)
+	setPathListInMap(elem, "scope", rule.Scope)
+	elem["sequence_number"] = rule.SequenceNumber
+	elem["nsx_id"] = rule.Id
+	elem["rule_id"] = rule.RuleId
+
+	var tagList []map[string]string
+	for _, tag := range rule.Tags {
+		tags := make(map[string]string)
+		tags["scope"] = *tag.Scope
+		tags["tag"] = *tag.Tag
+		tagList = append(tagList, tags)
+	}
+	elem["tag"] = tagList
+
+	return elem
+}
+
 func setPolicyRulesInSchema(d *schema.ResourceData, rules []model.Rule) error {
 	var rulesList []map[string]interface{}
 	for _, rule := range rules {
-		elem := make(map[string]interface{})
-		elem["display_name"] = rule.DisplayName
-		elem["description"] = rule.Description
-		elem["notes"] = rule.Notes
-		elem["logged"] = rule.Logged
-		elem["log_label"] = rule.Tag
-		elem["action"] = rule.Action
-		elem["destinations_excluded"] = rule.DestinationsExcluded
-		elem["sources_excluded"] = rule.SourcesExcluded
-		elem["ip_version"] = rule.IpProtocol
-		elem["direction"] = rule.Direction
-		elem["disabled"] = rule.Disabled
-		elem["revision"] = rule.Revision
-		setPathListInMap(elem, "source_groups", rule.SourceGroups)
-		setPathListInMap(elem, "destination_groups", rule.DestinationGroups)
-		setPathListInMap(elem, "profiles", rule.Profiles)
-		setPathListInMap(elem, "services", rule.Services)
-		setPathListInMap(elem, "scope", rule.Scope)
-		elem["sequence_number"] = rule.SequenceNumber
-		elem["nsx_id"] = rule.Id
-		elem["rule_id"] = rule.RuleId
-
-		var tagList []map[string]string
-		for _, tag := range rule.Tags {
-			tags := make(map[string]string)
-			tags["scope"] = *tag.Scope
-			tags["tag"] = *tag.Tag
-			tagList = append(tagList, tags)
-		}
-		elem["tag"] = tagList
-
-		rulesList = append(rulesList, elem)
+		rulesList = append(rulesList, getPolicyRuleElemFromModel(rule))
 	}
 
 	return d.Set("rule", rulesList)
 }
 
+func getPolicyRuleFromElem(data map[string]interface{}, sequenceNumber int64) model.Rule {
+	displayName := data["display_name"].(string)
+	description := data["description"].(string)
+	logged := data["logged"].(bool)
+	tag := data["log_label"].(string)
+	disabled := data["disabled"].(bool)
+	sourcesExcluded := data["sources_excluded"].(bool)
+	destinationsExcluded := data["destinations_excluded"].(bool)
+	ipProtocol := data["ip_version"].(string)
+	direction := data["direction"].(string)
+	notes := data["notes"].(string)
+	tagStructs := getPolicyTagsFromSet(data["tag"].(*schema.Set))
+
+	id := newUUID()
+	nsxID := data["nsx_id"].(string)
+	if nsxID != "" {
+		id = nsxID
+	}
+
+	// Action is not part of schema for rules that define it differently,
+	// such as TLS inspection rules
+	var action *string
+	if value, ok := data["action"].(string); ok {
+		action = &value
+	}
+
+	resourceType := "Rule"
+	return model.Rule{
+		ResourceType:         &resourceType,
+		Id:                   &id,
+		DisplayName:          &displayName,
+		Notes:                &notes,
+		Description:          &description,
+		Action:               action,
+		Logged:               &logged,
+		Tag:                  &tag,
+		Tags:                 tagStructs,
+		Disabled:             &disabled,
+		SourcesExcluded:      &sourcesExcluded,
+		DestinationsExcluded: &destinationsExcluded,
+		IpProtocol:           &ipProtocol,
+		Direction:            &direction,
+		SourceGroups:         getPathListFromMap(data, "source_groups"),
+		DestinationGroups:    getPathListFromMap(data, "destination_groups"),
+		Services:             getPathListFromMap(data, "services"),
+		Scope:                getPathListFromMap(data, "scope"),
+		Profiles:             getPathListFromMap(data, "profiles"),
+		SequenceNumber:       &sequenceNumber,
+	}
+}
+
 func getPolicyRulesFromSchema(d *schema.ResourceData) []model.Rule {
 	rules := d.Get("rule").([]interface{})
 	var ruleList []model.Rule
 	seq := 0
 	for _, rule := range rules {
 		data := rule.(map[string]interface{})
-		displayName := data["display_name"].(string)
-		description := data["description"].(string)
-		action := data["action"].(string)
-		logged := data["logged"].(bool)
-		tag := data["log_label"].(string)
-		disabled := data["disabled"].(bool)
-		sourcesExcluded := data["sources_excluded"].(bool)
-		destinationsExcluded := data["destinations_excluded"].(bool)
-		ipProtocol := data["ip_version"].(string)
-		direction := data["direction"].(string)
-		notes := data["notes"].(string)
-		sequenceNumber := int64(seq)
-		tagStructs := getPolicyTagsFromSet(data["tag"].(*schema.Set))
-
-		id := newUUID()
-		nsxID := data["nsx_id"].(string)
-		if nsxID != "" {
-			id = nsxID
-		}
-
-		resourceType := "Rule"
-		elem := model.Rule{
-			ResourceType:         &resourceType,
-			Id:                   &id,
-			DisplayName:          &displayName,
-			Notes:                &notes,
-			Description:          &description,
-			Action:               &action,
-			Logged:               &logged,
-			Tag:                  &tag,
-			Tags:                 tagStructs,
-			Disabled:             &disabled,
-			SourcesExcluded:      &sourcesExcluded,
-			DestinationsExcluded: &destinationsExcluded,
-			IpProtocol:           &ipProtocol,
-			Direction:            &direction,
-			SourceGroups:         getPathListFromMap(data, "source_groups"),
-			DestinationGroups:    getPathListFromMap(data, "destination_groups"),
-			Services:             getPathListFromMap(data, "services"),
-			Scope:                getPathListFromMap(data, "scope"),
-			Profiles:             getPathListFromMap(data, "profiles"),
-			SequenceNumber:       &sequenceNumber,
-		}
-
-		ruleList = append(ruleList, elem)
+		ruleList = append(ruleList, getPolicyRuleFromElem(data, int64(seq)))
 		seq = seq + 1
 	}
 
@@ -483,6 +496,17 @@ func parseGatewayPolicyPath(gwPath string) (bool, string) {
 	return isT0, segs[len(segs)-1]
 }
 
+// Parse group path into domain and group ID
+func parsePolicyGroupPath(groupPath string) (string, string, error) {
+	domain := getDomainFromResourcePath(groupPath)
+	groupID := getResourceIDFromResourcePath(groupPath, "groups")
+	if domain == "" || groupID == "" {
+		return "", "", fmt.Errorf("Expected group path, got %s", groupPath)
+	}
+
+	return domain, groupID, nil
+}
+
 func getPolicyPathSchema(isRequired bool, forceNew bool, description string) *schema.Schema {
 	return &schema.Schema{
 		Type:         schema.TypeString,
@@ -0,0 +1,295 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	policyOnConflictOverwrite = "overwrite"
+	policyOnConflictRetry     = "retry"
+	policyOnConflictFail      = "fail"
+)
+
+var policyOnConflictValues = []string{
+	policyOnConflictOverwrite,
+	policyOnConflictRetry,
+	policyOnConflictFail,
+}
+
+// Revision expected for the object being updated. When set on provider clients,
+// the first PATCH or PUT request to object path will carry this revision, unless
+// the request specifies _revision explicitly. Hierarchical (H-API) requests to
+// infra root are guarded as well, if the object is part of the request tree.
+type policyRevisionGuard struct {
+	Path     string
+	Revision int64
+	applied  bool
+}
+
+type policyRevisionProcessor struct {
+	guard *policyRevisionGuard
+}
+
+func newPolicyRevisionProcessor(guard *policyRevisionGuard) *policyRevisionProcessor {
+	return &policyRevisionProcessor{guard: guard}
+}
+
+func (processor policyRevisionProcessor) Process(req *http.Request) error {
+	guard := processor.guard
+	if guard.applied || req.Body == nil {
+		return nil
+	}
+	if req.Method != http.MethodPatch && req.Method != http.MethodPut {
+		return nil
+	}
+
+	hierarchical := false
+	if !strings.HasSuffix(req.URL.Path, "/api/v1"+guard.Path) {
+		root := getPolicyPathRoot(guard.Path)
+		if root == "" || !strings.HasSuffix(req.URL.Path, "/api/v1/"+root) {
+			return nil
+		}
+		hierarchical = true
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return err
+	}
+	req.Body.Close()
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	var obj map[string]interface{}
+	if err := json.Unmarshal(body, &obj); err != nil {
+		return nil
+	}
+
+	target := obj
+	if hierarchical {
+		target = findPolicyHierarchicalObject(obj, getPolicyPathIDs(guard.Path))
+		if target == nil {
+			// Object is not part of this request
+			return nil
+		}
+		// Revision is ignored in H-API unless check is enforced explicitly
+		query := req.URL.Query()
+		query.Set("enforce_revision_check", "true")
+		req.URL.RawQuery = query.Encode()
+	}
+
+	if _, ok := target["_revision"]; !ok {
+		target["_revision"] = guard.Revision
+		if newBody, err := json.Marshal(obj); err == nil {
+			body = newBody
+		}
+	}
+
+	guard.applied = true
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	return nil
+}
+
+// Root segment of policy path, e.g. infra or global-infra
+func getPolicyPathRoot(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 3 || len(segments)%2 == 0 {
+		return ""
+	}
+	return segments[0]
+}
+
+// Object IDs along policy path, e.g. [default, policy1] for
+// /infra/domains/default/security-policies/policy1
+func getPolicyPathIDs(path string) []string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	var ids []string
+	for i := 2; i < len(segments); i += 2 {
+		ids = append(ids, segments[i])
+	}
+	return ids
+}
+
+// Find object in H-API request tree, where each child is wrapped in a
+// Child<Type> object holding the actual object under its type key
+func findPolicyHierarchicalObject(obj map[string]interface{}, ids []string) map[string]interface{} {
+	if len(ids) == 0 {
+		return obj
+	}
+
+	children, _ := obj["children"].([]interface{})
+	for _, child := range children {
+		wrapper, ok := child.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for key, value := range wrapper {
+			if key == "resource_type" || key == "marked_for_delete" {
+				continue
+			}
+			childObj, ok := value.(map[string]interface{})
+			if !ok || childObj["id"] != ids[0] {
+				continue
+			}
+			if found := findPolicyHierarchicalObject(childObj, ids[1:]); found != nil {
+				return found
+			}
+		}
+	}
+
+	return nil
+}
+
+// Detect concurrent modification of policy objects on update, based on
+// revision and provider on_conflict setting
+func withPolicyConflictCheck(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for name, r := range resources {
+		if !strings.HasPrefix(name, "nsxt_policy_") || r.Update == nil || r.Read == nil {
+			continue
+		}
+		revisionSchema, ok := r.Schema["revision"]
+		if !ok || !revisionSchema.Computed || revisionSchema.Optional {
+			continue
+		}
+		pathSchema, ok := r.Schema["path"]
+		if !ok || !pathSchema.Computed || pathSchema.Optional {
+			continue
+		}
+
+		r.Update = policyConflictUpdateWrapper(r, r.Read, r.Update)
+	}
+
+	return resources
+}
+
+func policyConflictUpdateWrapper(r *schema.Resource, read schema.ReadFunc, update schema.UpdateFunc) schema.UpdateFunc {
+	return func(d *schema.ResourceData, m interface{}) error {
+		commonConfig := getCommonProviderConfig(m)
+		onConflict := commonConfig.OnConflict
+		if onConflict == "" || onConflict == policyOnConflictOverwrite {
+			return update(d, m)
+		}
+
+		path := d.Get("path").(string)
+		if path == "" {
+			return update(d, m)
+		}
+
+		var err error
+		for i := 0; i <= commonConfig.MaxRetries; i++ {
+			current, found, readErr := readPolicyObjectCurrentState(r, read, d, m)
+			if readErr != nil {
+				return readErr
+			}
+			if !found {
+				// Let update surface the error
+				return update(d, m)
+			}
+
+			stateRevision := d.Get("revision").(int)
+			currentRevision := current.Get("revision").(int)
+			if currentRevision != stateRevision {
+				changes := getPolicyConcurrentChanges(r, d, current)
+				if len(changes) > 0 && onConflict == policyOnConflictFail {
+					return policyConflictError(path, stateRevision, currentRevision, changes)
+				}
+				// Terraform attributes are applied on top of the current object.
+				// Note that resources updated with PUT overwrite object properties
+				// not modelled in Terraform.
+				log.Printf("[INFO] Object %s was modified outside Terraform (revision %d, expected %d), merging Terraform owned attributes", path, currentRevision, stateRevision)
+				d.Set("revision", currentRevision)
+			}
+
+			guardedClients := m.(nsxtClients)
+			guardedClients.PolicyRevisionGuard = &policyRevisionGuard{Path: path, Revision: int64(currentRevision)}
+			err = update(d, guardedClients)
+			if err == nil || onConflict != policyOnConflictRetry {
+				return err
+			}
+
+			// Retry only if update failed due to concurrent modification
+			latest, found, readErr := readPolicyObjectCurrentState(r, read, d, m)
+			if readErr != nil || !found || latest.Get("revision").(int) == currentRevision {
+				return err
+			}
+			log.Printf("[INFO] Object %s was modified concurrently during update, repeating operation, attempt %d", path, i+1)
+		}
+
+		return err
+	}
+}
+
+// Read current backend state of the object into a copy of resource data, without
+// affecting the planned values in d
+func readPolicyObjectCurrentState(r *schema.Resource, read schema.ReadFunc, d *schema.ResourceData, m interface{}) (*schema.ResourceData, bool, error) {
+	current := r.Data(nil)
+	current.SetId(d.Id())
+	for key := range r.Schema {
+		oldValue, _ := d.GetChange(key)
+		current.Set(key, oldValue)
+	}
+
+	if err := read(current, m); err != nil {
+		return nil, false, err
+	}
+
+	return current, current.Id() != "", nil
+}
+
+// List Terraform owned attributes that differ between last known state and backend
+func getPolicyConcurrentChanges(r *schema.Resource, d *schema.ResourceData, current *schema.ResourceData) []string {
+	var changes []string
+	for key, s := range r.Schema {
+		if !s.Optional && !s.Required {
+			continue
+		}
+		oldValue, _ := d.GetChange(key)
+		oldValue = normalizeSchemaValue(oldValue)
+		currentValue := normalizeSchemaValue(current.Get(key))
+		if !reflect.DeepEqual(oldValue, currentValue) {
+			changes = append(changes, fmt.Sprintf("%s: %v => %v", key, oldValue, currentValue))
+		}
+	}
+
+	sort.Strings(changes)
+	return changes
+}
+
+func normalizeSchemaValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *schema.Set:
+		return normalizeSchemaValue(v.List())
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, elem := range v {
+			result[i] = normalizeSchemaValue(elem)
+		}
+		return result
+	case map[string]interface{}:
+		result := make(map[string]interface{})
+		for key, elem := range v {
+			result[key] = normalizeSchemaValue(elem)
+		}
+		return result
+	}
+
+	return value
+}
+
+func policyConflictError(path string, stateRevision int, currentRevision int, changes []string) error {
+	return fmt.Errorf("Object %s was modified outside Terraform (revision %d, expected %d). Changed attributes:\n  %s\nPlease refresh and review the plan before applying again",
+		path, currentRevision, stateRevision, strings.Join(changes, "\n  "))
+}
@@ -0,0 +1,233 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testPolicyRevisionRequest(t *testing.T, method string, url string, body string) *http.Request {
+	req, err := http.NewRequest(method, url, bytes.NewBufferString(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return req
+}
+
+func testPolicyRevisionRequestBody(t *testing.T, req *http.Request) map[string]interface{} {
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.ContentLength != int64(len(body)) {
+		t.Errorf("Content length %d does not match body length %d", req.ContentLength, len(body))
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(body, &obj); err != nil {
+		t.Fatal(err)
+	}
+	return obj
+}
+
+func TestPolicyRevisionProcessorDirect(t *testing.T) {
+	guard := &policyRevisionGuard{Path: "/infra/ip-blocks/block1", Revision: 3}
+	processor := newPolicyRevisionProcessor(guard)
+
+	// Other object is not affected
+	req := testPolicyRevisionRequest(t, http.MethodPut, "https://nsx/policy/api/v1/infra/ip-blocks/block2", `{"id":"block2"}`)
+	if err := processor.Process(req); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := testPolicyRevisionRequestBody(t, req)["_revision"]; ok || guard.applied {
+		t.Errorf("Unexpected revision for unrelated object")
+	}
+
+	// Read requests are not affected
+	req = testPolicyRevisionRequest(t, http.MethodGet, "https://nsx/policy/api/v1/infra/ip-blocks/block1", `{}`)
+	if err := processor.Process(req); err != nil {
+		t.Fatal(err)
+	}
+	if guard.applied {
+		t.Errorf("Unexpected revision for GET request")
+	}
+
+	req = testPolicyRevisionRequest(t, http.MethodPut, "https://nsx/policy/api/v1/infra/ip-blocks/block1", `{"id":"block1"}`)
+	if err := processor.Process(req); err != nil {
+		t.Fatal(err)
+	}
+	if revision := testPolicyRevisionRequestBody(t, req)["_revision"]; revision != float64(3) {
+		t.Errorf("Expected revision 3, got %v", revision)
+	}
+	if !guard.applied {
+		t.Errorf("Expected guard to be applied")
+	}
+
+	// Guard is only applied once
+	req = testPolicyRevisionRequest(t, http.MethodPut, "https://nsx/policy/api/v1/infra/ip-blocks/block1", `{"id":"block1"}`)
+	if err := processor.Process(req); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := testPolicyRevisionRequestBody(t, req)["_revision"]; ok {
+		t.Errorf("Unexpected revision on second request")
+	}
+}
+
+func TestPolicyRevisionProcessorExplicitRevision(t *testing.T) {
+	guard := &policyRevisionGuard{Path: "/infra/ip-blocks/block1", Revision: 3}
+	processor := newPolicyRevisionProcessor(guard)
+
+	req := testPolicyRevisionRequest(t, http.MethodPatch, "https://nsx/policy/api/v1/infra/ip-blocks/block1", `{"id":"block1","_revision":5}`)
+	if err := processor.Process(req); err != nil {
+		t.Fatal(err)
+	}
+	if revision := testPolicyRevisionRequestBody(t, req)["_revision"]; revision != float64(5) {
+		t.Errorf("Expected explicit revision 5 to be kept, got %v", revision)
+	}
+}
+
+func TestPolicyRevisionProcessorHierarchical(t *testing.T) {
+	guard := &policyRevisionGuard{Path: "/infra/domains/default/security-policies/policy1", Revision: 7}
+	processor := newPolicyRevisionProcessor(guard)
+
+	body := `{"resource_type":"Infra","children":[{"resource_type":"ChildDomain","Domain":{"id":"default","resource_type":"Domain","children":[` +
+		`{"resource_type":"ChildSecurityPolicy","SecurityPolicy":{"id":"policy2","resource_type":"SecurityPolicy"}},` +
+		`{"resource_type":"ChildSecurityPolicy","SecurityPolicy":{"id":"policy1","resource_type":"SecurityPolicy"}}]}}]}`
+
+	// Tree without the object is not affected
+	req := testPolicyRevisionRequest(t, http.MethodPatch, "https://nsx/policy/api/v1/global-infra", body)
+	if err := processor.Process(req); err != nil {
+		t.Fatal(err)
+	}
+	if guard.applied {
+		t.Errorf("Unexpected revision for different root")
+	}
+
+	req = testPolicyRevisionRequest(t, http.MethodPatch, "https://nsx/policy/api/v1/infra?enforce_revision_check=false", body)
+	if err := processor.Process(req); err != nil {
+		t.Fatal(err)
+	}
+	if !guard.applied {
+		t.Fatalf("Expected guard to be applied")
+	}
+	if enforce := req.URL.Query().Get("enforce_revision_check"); enforce != "true" {
+		t.Errorf("Expected revision check to be enforced, got %s", enforce)
+	}
+
+	obj := testPolicyRevisionRequestBody(t, req)
+	domain := findPolicyHierarchicalObject(obj, []string{"default"})
+	if _, ok := domain["_revision"]; ok {
+		t.Errorf("Unexpected revision on parent object")
+	}
+	policy := findPolicyHierarchicalObject(obj, []string{"default", "policy1"})
+	if revision := policy["_revision"]; revision != float64(7) {
+		t.Errorf("Expected revision 7, got %v", revision)
+	}
+	sibling := findPolicyHierarchicalObject(obj, []string{"default", "policy2"})
+	if _, ok := sibling["_revision"]; ok {
+		t.Errorf("Unexpected revision on sibling object")
+	}
+}
+
+func TestGetPolicyPathIDs(t *testing.T) {
+	ids := getPolicyPathIDs("/infra/tier-1s/gw1/locale-services/default")
+	if !reflect.DeepEqual(ids, []string{"gw1", "default"}) {
+		t.Errorf("Unexpected ids %v", ids)
+	}
+	if root := getPolicyPathRoot("/global-infra/segments/seg1"); root != "global-infra" {
+		t.Errorf("Unexpected root %s", root)
+	}
+	if root := getPolicyPathRoot("/infra/segments"); root != "" {
+		t.Errorf("Unexpected root %s for collection path", root)
+	}
+}
+
+func TestNormalizeSchemaValue(t *testing.T) {
+	set := schema.NewSet(schema.HashString, []interface{}{"a"})
+	value := map[string]interface{}{
+		"list":   []interface{}{set, "b"},
+		"nested": map[string]interface{}{"set": set},
+		"scalar": 1,
+	}
+	expected := map[string]interface{}{
+		"list":   []interface{}{[]interface{}{"a"}, "b"},
+		"nested": map[string]interface{}{"set": []interface{}{"a"}},
+		"scalar": 1,
+	}
+
+	if normalized := normalizeSchemaValue(value); !reflect.DeepEqual(normalized, expected) {
+		t.Errorf("Expected %v, got %v", expected, normalized)
+	}
+}
+
+func testPolicyConflictResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"members": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func testPolicyConflictData(t *testing.T, r *schema.Resource, values map[string]interface{}) *schema.ResourceData {
+	d := r.Data(nil)
+	d.SetId("test")
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	state := d.State()
+	return r.Data(state)
+}
+
+func TestGetPolicyConcurrentChanges(t *testing.T) {
+	r := testPolicyConflictResource()
+	d := testPolicyConflictData(t, r, map[string]interface{}{
+		"display_name": "test",
+		"description":  "original",
+		"revision":     1,
+		"members":      []interface{}{"a", "b"},
+	})
+
+	// Computed attribute and reordered set are not reported
+	current := testPolicyConflictData(t, r, map[string]interface{}{
+		"display_name": "test",
+		"description":  "original",
+		"revision":     2,
+		"members":      []interface{}{"b", "a"},
+	})
+	if changes := getPolicyConcurrentChanges(r, d, current); len(changes) != 0 {
+		t.Errorf("Expected no changes, got %v", changes)
+	}
+
+	current = testPolicyConflictData(t, r, map[string]interface{}{
+		"display_name": "test",
+		"description":  "modified",
+		"revision":     2,
+		"members":      []interface{}{"a"},
+	})
+	changes := getPolicyConcurrentChanges(r, d, current)
+	if len(changes) != 2 {
+		t.Fatalf("Expected 2 changes, got %v", changes)
+	}
+	if changes[0] != "description: original => modified" {
+		t.Errorf("Unexpected change %s", changes[0])
+	}
+	if changes[1] != "members: [a b] => [a]" && changes[1] != "members: [b a] => [a]" {
+		t.Errorf("Unexpected change %s", changes[1])
+	}
+}
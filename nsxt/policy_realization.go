@@ -0,0 +1,262 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/realized_state"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+// Number of empty realization replies tolerated before deciding that the
+// object has no realized entities at all (not all policy objects do)
+const policyRealizationNotFoundChecks = 10
+
+func getRealizationErrorsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Realization errors reported by NSX for this resource",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"entity_type": {
+					Type:        schema.TypeString,
+					Description: "The entity type of the realized resource",
+					Computed:    true,
+				},
+				"state": {
+					Type:        schema.TypeString,
+					Description: "The state of the realized resource",
+					Computed:    true,
+				},
+				"message": {
+					Type:        schema.TypeString,
+					Description: "Realization alarm message",
+					Computed:    true,
+				},
+				"error_code": {
+					Type:        schema.TypeInt,
+					Description: "Error code reported with the alarm",
+					Computed:    true,
+				},
+				"error_message": {
+					Type:        schema.TypeString,
+					Description: "Error message reported with the alarm",
+					Computed:    true,
+				},
+				"details": {
+					Type:        schema.TypeString,
+					Description: "Further details on the error, such as remediation steps",
+					Computed:    true,
+				},
+				"related_errors": {
+					Type:        schema.TypeList,
+					Description: "Related error messages",
+					Computed:    true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Extend all policy resources that expose path with realization_errors attribute,
+// and check realization after create and update if enabled in provider. Read is
+// not extended, so that refresh is never delayed by realization wait; the
+// attribute reflects realization as of last apply.
+func withPolicyRealizationCheck(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for name, r := range resources {
+		if !strings.HasPrefix(name, "nsxt_policy_") {
+			continue
+		}
+		pathSchema, ok := r.Schema["path"]
+		if !ok || !pathSchema.Computed || pathSchema.Optional {
+			continue
+		}
+		if _, ok := r.Schema["realization_errors"]; ok {
+			continue
+		}
+
+		r.Schema["realization_errors"] = getRealizationErrorsSchema()
+		r.Create = policyRealizationApplyWrapper(r.Create, schema.TimeoutCreate)
+		if r.Update != nil {
+			r.Update = policyRealizationApplyWrapper(r.Update, schema.TimeoutUpdate)
+		}
+	}
+
+	return resources
+}
+
+func isPolicyRealizationCheckEnabled(m interface{}) bool {
+	// Realization on Global Manager is site specific and is not supported here
+	return getCommonProviderConfig(m).CheckRealization && !isPolicyGlobalManager(m)
+}
+
+func policyRealizationApplyWrapper(apply func(*schema.ResourceData, interface{}) error, timeoutKey string) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, m interface{}) error {
+		err := apply(d, m)
+		if err != nil || d.Id() == "" || !isPolicyRealizationCheckEnabled(m) {
+			return err
+		}
+
+		path := d.Get("path").(string)
+		if path == "" {
+			return nil
+		}
+
+		return checkPolicyRealization(d, getPolicyConnector(m), path, d.Timeout(timeoutKey))
+	}
+}
+
+func listPolicyRealizedEntities(connector *client.RestConnector, path string) ([]model.GenericPolicyRealizedResource, error) {
+	client := realized_state.NewRealizedEntitiesClient(connector)
+	realizationResult, err := client.List(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return realizationResult.Results, nil
+}
+
+func getPolicyRealizationState(entities []model.GenericPolicyRealizedResource) string {
+	state := model.GenericPolicyRealizedResource_STATE_REALIZED
+	for _, entity := range entities {
+		if entity.State == nil {
+			return "UNKNOWN"
+		}
+		if *entity.State == model.GenericPolicyRealizedResource_STATE_ERROR {
+			return *entity.State
+		}
+		if *entity.State == model.GenericPolicyRealizedResource_STATE_UNREALIZED {
+			state = *entity.State
+		}
+	}
+
+	return state
+}
+
+func checkPolicyRealization(d *schema.ResourceData, connector *client.RestConnector, path string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"UNKNOWN", model.GenericPolicyRealizedResource_STATE_UNREALIZED},
+		Target: []string{
+			model.GenericPolicyRealizedResource_STATE_REALIZED,
+			model.GenericPolicyRealizedResource_STATE_ERROR,
+			model.GenericPolicyRealizedResource_STATE_UNAVAILABLE,
+		},
+		Refresh: func() (interface{}, string, error) {
+			entities, err := listPolicyRealizedEntities(connector, path)
+			if err != nil {
+				return nil, "", err
+			}
+			if len(entities) == 0 {
+				// Realization info not found yet
+				return nil, "", nil
+			}
+			return entities, getPolicyRealizationState(entities), nil
+		},
+		Timeout:        timeout,
+		MinTimeout:     1 * time.Second,
+		Delay:          1 * time.Second,
+		NotFoundChecks: policyRealizationNotFoundChecks,
+	}
+
+	result, err := stateConf.WaitForState()
+	if err != nil {
+		if _, ok := err.(*resource.NotFoundError); ok {
+			log.Printf("[DEBUG] No realized entities found for %s", path)
+			d.Set("realization_errors", nil)
+			return nil
+		}
+		return fmt.Errorf("Failed to get realization information for %s: %v", path, err)
+	}
+
+	realizationErrors := getPolicyRealizationErrors(result.([]model.GenericPolicyRealizedResource))
+	d.Set("realization_errors", realizationErrors)
+	if len(realizationErrors) > 0 {
+		return policyRealizationError(path, realizationErrors)
+	}
+
+	return nil
+}
+
+func getPolicyRealizationErrors(entities []model.GenericPolicyRealizedResource) []map[string]interface{} {
+	var errorList []map[string]interface{}
+	for _, entity := range entities {
+		if entity.State == nil || *entity.State != model.GenericPolicyRealizedResource_STATE_ERROR {
+			continue
+		}
+
+		if len(entity.Alarms) == 0 {
+			elem := make(map[string]interface{})
+			elem["entity_type"] = entity.EntityType
+			elem["state"] = entity.State
+			if entity.RuntimeError != nil {
+				elem["message"] = entity.RuntimeError
+			} else {
+				elem["message"] = entity.PublishStatusError
+			}
+			errorList = append(errorList, elem)
+			continue
+		}
+
+		for _, alarm := range entity.Alarms {
+			elem := make(map[string]interface{})
+			elem["entity_type"] = entity.EntityType
+			elem["state"] = entity.State
+			elem["message"] = alarm.Message
+			if alarm.ErrorDetails != nil {
+				elem["error_code"] = alarm.ErrorDetails.ErrorCode
+				elem["error_message"] = alarm.ErrorDetails.ErrorMessage
+				elem["details"] = alarm.ErrorDetails.Details
+				var relatedErrors []string
+				for _, relatedErr := range alarm.ErrorDetails.RelatedErrors {
+					if relatedErr.ErrorMessage != nil {
+						relatedErrors = append(relatedErrors, *relatedErr.ErrorMessage)
+					}
+				}
+				elem["related_errors"] = relatedErrors
+			}
+			errorList = append(errorList, elem)
+		}
+	}
+
+	return errorList
+}
+
+func policyRealizationError(path string, realizationErrors []map[string]interface{}) error {
+	msg := fmt.Sprintf("Realization of %s failed:", path)
+	for _, elem := range realizationErrors {
+		msg += "\n"
+		if entityType, ok := elem["entity_type"].(*string); ok && entityType != nil {
+			msg += fmt.Sprintf("[%s] ", *entityType)
+		}
+		if message, ok := elem["message"].(*string); ok && message != nil {
+			msg += *message
+		}
+		if errorMessage, ok := elem["error_message"].(*string); ok && errorMessage != nil {
+			msg += fmt.Sprintf(": %s", *errorMessage)
+		}
+		if errorCode, ok := elem["error_code"].(*int64); ok && errorCode != nil {
+			msg += fmt.Sprintf(" (code %v)", *errorCode)
+		}
+		if details, ok := elem["details"].(*string); ok && details != nil {
+			msg += fmt.Sprintf("\nDetails: %s", *details)
+		}
+		if relatedErrors, ok := elem["related_errors"].([]string); ok && len(relatedErrors) > 0 {
+			msg += fmt.Sprintf("\nRelated errors: %s", strings.Join(relatedErrors, "; "))
+		}
+	}
+
+	return errors.New(msg)
+}
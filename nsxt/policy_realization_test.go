@@ -0,0 +1,100 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func testPolicyRealizedEntity(entityType string, state string, runtimeError string, alarms []model.PolicyAlarmResource) model.GenericPolicyRealizedResource {
+	entity := model.GenericPolicyRealizedResource{
+		EntityType: &entityType,
+		State:      &state,
+		Alarms:     alarms,
+	}
+	if runtimeError != "" {
+		entity.RuntimeError = &runtimeError
+	}
+	return entity
+}
+
+func TestGetPolicyRealizationErrors(t *testing.T) {
+	alarmMessage := "Edge node is not ready"
+	errorMessage := "Edge cluster has no members"
+	errorCode := int64(500012)
+	details := "Add edge nodes to the cluster"
+	relatedMessage := "Transport node is down"
+	alarm := model.PolicyAlarmResource{
+		Message: &alarmMessage,
+		ErrorDetails: &model.PolicyApiError{
+			ErrorCode:     &errorCode,
+			ErrorMessage:  &errorMessage,
+			Details:       &details,
+			RelatedErrors: []model.PolicyRelatedApiError{{ErrorMessage: &relatedMessage}},
+		},
+	}
+
+	entities := []model.GenericPolicyRealizedResource{
+		testPolicyRealizedEntity("RealizedLogicalRouter", model.GenericPolicyRealizedResource_STATE_REALIZED, "", nil),
+		testPolicyRealizedEntity("RealizedLogicalRouterPort", model.GenericPolicyRealizedResource_STATE_ERROR, "Port has no IP", nil),
+		testPolicyRealizedEntity("RealizedEdgeService", model.GenericPolicyRealizedResource_STATE_ERROR, "", []model.PolicyAlarmResource{alarm}),
+	}
+
+	errorList := getPolicyRealizationErrors(entities)
+	if len(errorList) != 2 {
+		t.Fatalf("Expected 2 realization errors, got %d", len(errorList))
+	}
+
+	if message := errorList[0]["message"].(*string); *message != "Port has no IP" {
+		t.Errorf("Expected runtime error as message, got %s", *message)
+	}
+	if _, ok := errorList[0]["error_code"]; ok {
+		t.Errorf("Expected no error code for entity without alarms")
+	}
+
+	if message := errorList[1]["message"].(*string); *message != alarmMessage {
+		t.Errorf("Expected alarm message, got %s", *message)
+	}
+	if code := errorList[1]["error_code"].(*int64); *code != errorCode {
+		t.Errorf("Expected error code %d, got %d", errorCode, *code)
+	}
+	related := errorList[1]["related_errors"].([]string)
+	if len(related) != 1 || related[0] != relatedMessage {
+		t.Errorf("Unexpected related errors %v", related)
+	}
+
+	if errorList := getPolicyRealizationErrors(entities[:1]); len(errorList) != 0 {
+		t.Errorf("Expected no realization errors for realized entity, got %d", len(errorList))
+	}
+}
+
+func TestPolicyRealizationError(t *testing.T) {
+	entityType := "RealizedSegment"
+	message := "Failed to allocate 100% of VNI pool"
+	errorMessage := "VNI %s exhausted"
+	errorCode := int64(8301)
+	realizationErrors := []map[string]interface{}{
+		{
+			"entity_type":    &entityType,
+			"message":        &message,
+			"error_message":  &errorMessage,
+			"error_code":     &errorCode,
+			"related_errors": []string{"first", "second"},
+		},
+	}
+
+	err := policyRealizationError("/infra/segments/test", realizationErrors)
+	expected := "Realization of /infra/segments/test failed:\n" +
+		"[RealizedSegment] Failed to allocate 100% of VNI pool: VNI %s exhausted (code 8301)\n" +
+		"Related errors: first; second"
+	if err.Error() != expected {
+		t.Errorf("Unexpected error message:\n%s\nexpected:\n%s", err.Error(), expected)
+	}
+	if strings.Contains(err.Error(), "%!") {
+		t.Errorf("Error message contains formatting artifacts: %s", err.Error())
+	}
+}
@@ -0,0 +1,459 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_domains "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/domains"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+const (
+	policyRuleAnalysisOff  = "off"
+	policyRuleAnalysisWarn = "warn"
+	policyRuleAnalysisFail = "fail"
+)
+
+var policyRuleAnalysisValues = []string{
+	policyRuleAnalysisOff,
+	policyRuleAnalysisWarn,
+	policyRuleAnalysisFail,
+}
+
+// Value terraform uses in plan for attributes not known until apply
+const unknownSchemaValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+func isUnknownSchemaValue(value string) bool {
+	return strings.HasPrefix(value, unknownSchemaValue)
+}
+
+type ipRange struct {
+	start net.IP
+	end   net.IP
+}
+
+func (r ipRange) contains(other ipRange) bool {
+	return bytes.Compare(r.start, other.start) <= 0 && bytes.Compare(r.end, other.end) >= 0
+}
+
+// Source or destination of a rule. Group paths that could not be resolved
+// to IP addresses, as well as unknown values, are kept as opaque tokens
+type ruleEndpoints struct {
+	any    bool
+	raw    []string
+	tokens map[string]bool
+	ranges []ipRange
+}
+
+type analyzedRule struct {
+	name                 string
+	action               string
+	direction            string
+	ipVersion            string
+	disabled             bool
+	sourcesExcluded      bool
+	destinationsExcluded bool
+	sources              ruleEndpoints
+	destinations         ruleEndpoints
+	services             []string
+	scope                []string
+	profiles             []string
+}
+
+func parseIPRange(value string) (ipRange, bool) {
+	if strings.Contains(value, "/") {
+		_, ipNet, err := net.ParseCIDR(value)
+		if err != nil {
+			return ipRange{}, false
+		}
+		start := ipNet.IP
+		end := make(net.IP, len(start))
+		for i := range start {
+			end[i] = start[i] | ^ipNet.Mask[i]
+		}
+		return ipRange{start: start.To16(), end: end.To16()}, true
+	}
+
+	if strings.Contains(value, "-") {
+		bounds := strings.SplitN(value, "-", 2)
+		start := net.ParseIP(strings.TrimSpace(bounds[0]))
+		end := net.ParseIP(strings.TrimSpace(bounds[1]))
+		if start == nil || end == nil {
+			return ipRange{}, false
+		}
+		return ipRange{start: start.To16(), end: end.To16()}, true
+	}
+
+	ip := net.ParseIP(value)
+	if ip == nil {
+		return ipRange{}, false
+	}
+	return ipRange{start: ip.To16(), end: ip.To16()}, true
+}
+
+// Get IP ranges of group, if group is defined by IP address expressions only
+func getPolicyGroupIPRanges(group model.Group) ([]ipRange, bool) {
+	if len(group.Expression) == 0 {
+		return nil, false
+	}
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+	var ranges []ipRange
+	for _, expression := range group.Expression {
+		expData, errs := converter.ConvertToGolang(expression, model.ExpressionBindingType())
+		if len(errs) > 0 {
+			return nil, false
+		}
+		switch expData.(model.Expression).ResourceType {
+		case model.ConjunctionOperator__TYPE_IDENTIFIER:
+			conjData, errs := converter.ConvertToGolang(expression, model.ConjunctionOperatorBindingType())
+			if len(errs) > 0 {
+				return nil, false
+			}
+			conjunction := conjData.(model.ConjunctionOperator).ConjunctionOperator
+			if conjunction == nil || *conjunction != model.ConjunctionOperator_CONJUNCTION_OPERATOR_OR {
+				return nil, false
+			}
+		case model.IPAddressExpression__TYPE_IDENTIFIER:
+			ipData, errs := converter.ConvertToGolang(expression, model.IPAddressExpressionBindingType())
+			if len(errs) > 0 {
+				return nil, false
+			}
+			for _, address := range ipData.(model.IPAddressExpression).IpAddresses {
+				addrRange, ok := parseIPRange(address)
+				if !ok {
+					return nil, false
+				}
+				ranges = append(ranges, addrRange)
+			}
+		default:
+			return nil, false
+		}
+	}
+
+	return ranges, true
+}
+
+func resolvePolicyGroupIPRanges(connector *client.RestConnector, groupPath string, isGlobalManager bool) ([]ipRange, bool) {
+	domain := getDomainFromResourcePath(groupPath)
+	groupID := getResourceIDFromResourcePath(groupPath, "groups")
+	if domain == "" || groupID == "" {
+		return nil, false
+	}
+
+	var group model.Group
+	if isGlobalManager {
+		gmObj, err := gm_domains.NewGroupsClient(connector).Get(domain, groupID)
+		if err != nil {
+			log.Printf("[DEBUG] Rule analysis could not read group %s: %v", groupPath, err)
+			return nil, false
+		}
+		rawObj, err := convertModelBindingType(gmObj, gm_model.GroupBindingType(), model.GroupBindingType())
+		if err != nil {
+			return nil, false
+		}
+		group = rawObj.(model.Group)
+	} else {
+		var err error
+		group, err = domains.NewGroupsClient(connector).Get(domain, groupID)
+		if err != nil {
+			log.Printf("[DEBUG] Rule analysis could not read group %s: %v", groupPath, err)
+			return nil, false
+		}
+	}
+
+	return getPolicyGroupIPRanges(group)
+}
+
+// Resolves group path to IP ranges, returns false if group should be compared
+// by path only
+type policyGroupResolver func(groupPath string) ([]ipRange, bool)
+
+// Group IP ranges resolved during provider run. Terraform starts separate
+// provider process for plan, hence each group referenced in rules is read
+// once per plan.
+type policyGroupIPRangesCache struct {
+	lock   sync.Mutex
+	groups map[string][]ipRange
+	valid  map[string]bool
+}
+
+func newPolicyGroupIPRangesCache() *policyGroupIPRangesCache {
+	return &policyGroupIPRangesCache{
+		groups: make(map[string][]ipRange),
+		valid:  make(map[string]bool),
+	}
+}
+
+func (c *policyGroupIPRangesCache) resolver(resolve policyGroupResolver) policyGroupResolver {
+	if c == nil {
+		return resolve
+	}
+
+	return func(groupPath string) ([]ipRange, bool) {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		if valid, ok := c.valid[groupPath]; ok {
+			return c.groups[groupPath], valid
+		}
+		ranges, valid := resolve(groupPath)
+		c.groups[groupPath] = ranges
+		c.valid[groupPath] = valid
+		return ranges, valid
+	}
+}
+
+func newPolicyRuleEndpoints(values []string, resolveGroup policyGroupResolver) ruleEndpoints {
+	endpoints := ruleEndpoints{tokens: make(map[string]bool)}
+	if len(values) == 0 {
+		endpoints.any = true
+		return endpoints
+	}
+
+	for i, value := range values {
+		if isUnknownSchemaValue(value) {
+			// unknown value never matches anything else
+			value = fmt.Sprintf("%s-%d", unknownSchemaValue, i)
+			endpoints.tokens[value] = true
+			endpoints.raw = append(endpoints.raw, value)
+			continue
+		}
+		endpoints.raw = append(endpoints.raw, value)
+		if addrRange, ok := parseIPRange(value); ok {
+			endpoints.ranges = append(endpoints.ranges, addrRange)
+			continue
+		}
+		if ranges, ok := resolveGroup(value); ok {
+			endpoints.ranges = append(endpoints.ranges, ranges...)
+			continue
+		}
+		endpoints.tokens[value] = true
+	}
+	sort.Strings(endpoints.raw)
+
+	return endpoints
+}
+
+func (e ruleEndpoints) covers(other ruleEndpoints) bool {
+	if e.any {
+		return true
+	}
+	if other.any {
+		return false
+	}
+
+	for token := range other.tokens {
+		if isUnknownSchemaValue(token) || !e.tokens[token] {
+			return false
+		}
+	}
+
+	for _, otherRange := range other.ranges {
+		covered := false
+		for _, addrRange := range e.ranges {
+			if addrRange.contains(otherRange) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Empty path list stands for ANY
+func policyPathListCovers(list []string, other []string) bool {
+	if len(list) == 0 {
+		return true
+	}
+	if len(other) == 0 {
+		return false
+	}
+	for _, value := range other {
+		if isUnknownSchemaValue(value) || !stringInList(value, list) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func policyStringListsEqual(list []string, other []string) bool {
+	if len(list) != len(other) {
+		return false
+	}
+	for i := range list {
+		if list[i] != other[i] || isUnknownSchemaValue(list[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func getAnalyzedRuleSortedList(data map[string]interface{}, key string) []string {
+	result := interface2StringList(data[key].(*schema.Set).List())
+	sort.Strings(result)
+	return result
+}
+
+func newAnalyzedRule(index int, data map[string]interface{}, resolveGroup policyGroupResolver) analyzedRule {
+	name := data["display_name"].(string)
+	if name == "" || isUnknownSchemaValue(name) {
+		name = fmt.Sprintf("#%d", index)
+	}
+
+	return analyzedRule{
+		name:                 name,
+		action:               data["action"].(string),
+		direction:            data["direction"].(string),
+		ipVersion:            data["ip_version"].(string),
+		disabled:             data["disabled"].(bool),
+		sourcesExcluded:      data["sources_excluded"].(bool),
+		destinationsExcluded: data["destinations_excluded"].(bool),
+		sources:              newPolicyRuleEndpoints(getAnalyzedRuleSortedList(data, "source_groups"), resolveGroup),
+		destinations:         newPolicyRuleEndpoints(getAnalyzedRuleSortedList(data, "destination_groups"), resolveGroup),
+		services:             getAnalyzedRuleSortedList(data, "services"),
+		scope:                getAnalyzedRuleSortedList(data, "scope"),
+		profiles:             getAnalyzedRuleSortedList(data, "profiles"),
+	}
+}
+
+func (r analyzedRule) hasSameMatch(other analyzedRule) bool {
+	return r.direction == other.direction &&
+		r.ipVersion == other.ipVersion &&
+		r.sourcesExcluded == other.sourcesExcluded &&
+		r.destinationsExcluded == other.destinationsExcluded &&
+		policyStringListsEqual(r.sources.raw, other.sources.raw) &&
+		policyStringListsEqual(r.destinations.raw, other.destinations.raw) &&
+		policyStringListsEqual(r.services, other.services) &&
+		policyStringListsEqual(r.scope, other.scope) &&
+		policyStringListsEqual(r.profiles, other.profiles)
+}
+
+// Check whether all traffic matched by other rule is matched by this rule
+func (r analyzedRule) covers(other analyzedRule) bool {
+	if r.direction != model.Rule_DIRECTION_IN_OUT && r.direction != other.direction {
+		return false
+	}
+	if r.ipVersion != model.Rule_IP_PROTOCOL_IPV4_IPV6 && r.ipVersion != other.ipVersion {
+		return false
+	}
+
+	// Negated groups are only compared literally
+	if r.sourcesExcluded || other.sourcesExcluded {
+		if r.sourcesExcluded != other.sourcesExcluded || !policyStringListsEqual(r.sources.raw, other.sources.raw) {
+			return false
+		}
+	} else if !r.sources.covers(other.sources) {
+		return false
+	}
+	if r.destinationsExcluded || other.destinationsExcluded {
+		if r.destinationsExcluded != other.destinationsExcluded || !policyStringListsEqual(r.destinations.raw, other.destinations.raw) {
+			return false
+		}
+	} else if !r.destinations.covers(other.destinations) {
+		return false
+	}
+
+	return policyPathListCovers(r.services, other.services) &&
+		policyPathListCovers(r.scope, other.scope) &&
+		policyPathListCovers(r.profiles, other.profiles)
+}
+
+func (r analyzedRule) isOverlyBroad() bool {
+	return r.action == model.Rule_ACTION_ALLOW &&
+		!r.sourcesExcluded && !r.destinationsExcluded &&
+		r.sources.any && r.destinations.any &&
+		len(r.services) == 0 && len(r.profiles) == 0
+}
+
+func analyzePolicyRules(rules []analyzedRule, category string, approvedCategories []string) []string {
+	var findings []string
+	for i, rule := range rules {
+		if rule.disabled {
+			continue
+		}
+
+		for _, earlier := range rules[:i] {
+			if earlier.disabled {
+				continue
+			}
+			if earlier.action == rule.action && earlier.hasSameMatch(rule) {
+				findings = append(findings, fmt.Sprintf("rule %s is a duplicate of rule %s", rule.name, earlier.name))
+				break
+			}
+			if earlier.action != rule.action && earlier.action != model.Rule_ACTION_JUMP_TO_APPLICATION && earlier.covers(rule) {
+				findings = append(findings, fmt.Sprintf("rule %s (%s) is shadowed by earlier rule %s (%s)", rule.name, rule.action, earlier.name, earlier.action))
+				break
+			}
+		}
+
+		if rule.isOverlyBroad() && !stringInList(category, approvedCategories) {
+			findings = append(findings, fmt.Sprintf("rule %s allows ANY source, destination and service in category %s", rule.name, category))
+		}
+	}
+
+	return findings
+}
+
+// CustomizeDiff for security and gateway policies, that analyzes planned rules
+// if enabled in provider configuration
+func policyRulesAnalysisCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if m == nil {
+		return nil
+	}
+	commonConfig := getCommonProviderConfig(m)
+	mode := commonConfig.FirewallRuleAnalysis
+	if mode == "" || mode == policyRuleAnalysisOff {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChange("rule") {
+		return nil
+	}
+
+	connector := getPolicyConnector(m)
+	isGlobalManager := isPolicyGlobalManager(m)
+	resolveGroup := m.(nsxtClients).PolicyGroupIPRanges.resolver(func(groupPath string) ([]ipRange, bool) {
+		return resolvePolicyGroupIPRanges(connector, groupPath, isGlobalManager)
+	})
+
+	var rules []analyzedRule
+	for i, rule := range d.Get("rule").([]interface{}) {
+		rules = append(rules, newAnalyzedRule(i, rule.(map[string]interface{}), resolveGroup))
+	}
+
+	category := d.Get("category").(string)
+	findings := analyzePolicyRules(rules, category, commonConfig.FirewallRuleAnalysisApprovedCategories)
+	if len(findings) == 0 {
+		return nil
+	}
+
+	name := d.Get("display_name").(string)
+	if mode == policyRuleAnalysisFail {
+		return fmt.Errorf("Rule analysis of policy %s failed:\n  %s", name, strings.Join(findings, "\n  "))
+	}
+
+	// CustomizeDiff can not return warnings
+	for _, finding := range findings {
+		log.Printf("[WARN] Rule analysis of policy %s: %s", name, finding)
+	}
+	return nil
+}
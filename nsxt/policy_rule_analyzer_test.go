@@ -0,0 +1,244 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+// Groups resolved to addresses in tests below, other groups are compared by path
+var testPolicyAnalyzedGroups = map[string][]string{
+	"/infra/domains/default/groups/lab":  {"10.0.0.0/16"},
+	"/infra/domains/default/groups/dev":  {"10.0.1.0/24", "10.0.2.10-10.0.2.20"},
+	"/infra/domains/default/groups/host": {"10.1.0.5"},
+}
+
+func testPolicyGroupResolver(groupPath string) ([]ipRange, bool) {
+	addresses, ok := testPolicyAnalyzedGroups[groupPath]
+	if !ok {
+		return nil, false
+	}
+	var ranges []ipRange
+	for _, address := range addresses {
+		addrRange, _ := parseIPRange(address)
+		ranges = append(ranges, addrRange)
+	}
+	return ranges, true
+}
+
+func testPolicyAnalyzedRule(name string, action string, sources []string, destinations []string, services []string) analyzedRule {
+	toSet := func(values []string) *schema.Set {
+		var list []interface{}
+		for _, value := range values {
+			list = append(list, value)
+		}
+		return schema.NewSet(schema.HashString, list)
+	}
+
+	data := map[string]interface{}{
+		"display_name":          name,
+		"action":                action,
+		"direction":             "IN_OUT",
+		"ip_version":            "IPV4_IPV6",
+		"disabled":              false,
+		"sources_excluded":      false,
+		"destinations_excluded": false,
+		"source_groups":         toSet(sources),
+		"destination_groups":    toSet(destinations),
+		"services":              toSet(services),
+		"scope":                 toSet(nil),
+		"profiles":              toSet(nil),
+	}
+
+	return newAnalyzedRule(0, data, testPolicyGroupResolver)
+}
+
+func TestPolicyRulesAnalysis(t *testing.T) {
+	web := "/infra/domains/default/groups/web"
+	app := "/infra/domains/default/groups/app"
+	http := "/infra/services/HTTP"
+	lab := "/infra/domains/default/groups/lab"
+	dev := "/infra/domains/default/groups/dev"
+	host := "/infra/domains/default/groups/host"
+
+	cases := []struct {
+		name     string
+		rules    []analyzedRule
+		findings []string
+	}{
+		{
+			name: "shadowed by CIDR",
+			rules: []analyzedRule{
+				testPolicyAnalyzedRule("deny-net", "DROP", []string{"10.0.0.0/16"}, nil, nil),
+				testPolicyAnalyzedRule("allow-web", "ALLOW", []string{"10.0.1.0/24"}, []string{app}, []string{http}),
+			},
+			findings: []string{"rule allow-web (ALLOW) is shadowed by earlier rule deny-net (DROP)"},
+		},
+		{
+			name: "group not resolved to addresses",
+			rules: []analyzedRule{
+				testPolicyAnalyzedRule("deny-net", "DROP", []string{"10.0.0.0/16"}, nil, nil),
+				testPolicyAnalyzedRule("allow-web", "ALLOW", []string{web}, []string{app}, []string{http}),
+			},
+		},
+		{
+			name: "CIDR shadowed by group",
+			rules: []analyzedRule{
+				testPolicyAnalyzedRule("deny-lab", "DROP", []string{lab}, nil, nil),
+				testPolicyAnalyzedRule("allow-net", "ALLOW", []string{"10.0.3.0/24"}, []string{app}, []string{http}),
+			},
+			findings: []string{"rule allow-net (ALLOW) is shadowed by earlier rule deny-lab (DROP)"},
+		},
+		{
+			name: "group shadowed by CIDR",
+			rules: []analyzedRule{
+				testPolicyAnalyzedRule("deny-net", "DROP", nil, []string{"10.0.0.0/8"}, nil),
+				testPolicyAnalyzedRule("allow-dev", "ALLOW", nil, []string{dev}, []string{http}),
+			},
+			findings: []string{"rule allow-dev (ALLOW) is shadowed by earlier rule deny-net (DROP)"},
+		},
+		{
+			name: "group shadowed by group",
+			rules: []analyzedRule{
+				testPolicyAnalyzedRule("deny-lab", "DROP", []string{lab}, nil, nil),
+				testPolicyAnalyzedRule("allow-dev", "ALLOW", []string{dev}, []string{app}, []string{http}),
+			},
+			findings: []string{"rule allow-dev (ALLOW) is shadowed by earlier rule deny-lab (DROP)"},
+		},
+		{
+			name: "group not covered by group",
+			rules: []analyzedRule{
+				testPolicyAnalyzedRule("deny-lab", "DROP", []string{lab}, nil, nil),
+				testPolicyAnalyzedRule("allow-host", "ALLOW", []string{host}, nil, []string{http}),
+			},
+		},
+		{
+			name: "duplicate",
+			rules: []analyzedRule{
+				testPolicyAnalyzedRule("first", "ALLOW", []string{app}, []string{web}, []string{http}),
+				testPolicyAnalyzedRule("second", "ALLOW", []string{app}, []string{web}, []string{http}),
+			},
+			findings: []string{"rule second is a duplicate of rule first"},
+		},
+		{
+			name: "not shadowed by narrower rule",
+			rules: []analyzedRule{
+				testPolicyAnalyzedRule("deny-host", "DROP", []string{"10.0.1.5"}, nil, nil),
+				testPolicyAnalyzedRule("allow-web", "ALLOW", []string{"10.0.1.0/24"}, nil, []string{http}),
+			},
+		},
+		{
+			name: "unresolved groups",
+			rules: []analyzedRule{
+				testPolicyAnalyzedRule("deny-app", "DROP", []string{app}, nil, []string{http}),
+				testPolicyAnalyzedRule("allow-unknown", "ALLOW", []string{unknownSchemaValue}, nil, []string{http}),
+			},
+		},
+		{
+			name: "overly broad",
+			rules: []analyzedRule{
+				testPolicyAnalyzedRule("allow-all", "ALLOW", nil, nil, nil),
+			},
+			findings: []string{"rule allow-all allows ANY source, destination and service in category Application"},
+		},
+	}
+
+	for _, tc := range cases {
+		findings := analyzePolicyRules(tc.rules, "Application", []string{"Infrastructure"})
+		if strings.Join(findings, "\n") != strings.Join(tc.findings, "\n") {
+			t.Errorf("%s: expected findings %v, got %v", tc.name, tc.findings, findings)
+		}
+	}
+
+	findings := analyzePolicyRules([]analyzedRule{testPolicyAnalyzedRule("allow-all", "ALLOW", nil, nil, nil)}, "Infrastructure", []string{"Infrastructure"})
+	if len(findings) != 0 {
+		t.Errorf("approved category: expected no findings, got %v", findings)
+	}
+}
+
+func testPolicyAnalyzedGroup(expressions ...*data.StructValue) model.Group {
+	return model.Group{Expression: expressions}
+}
+
+func TestGetPolicyGroupIPRanges(t *testing.T) {
+	ipData := func(addresses ...string) *data.StructValue {
+		value, err := buildGroupIPAddressData(map[string]interface{}{
+			"ip_addresses": schema.NewSet(schema.HashString, stringList2Interface(addresses)),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return value
+	}
+	conjunctionData := func(operator string) *data.StructValue {
+		value, err := buildGroupConjunctionData(operator)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return value
+	}
+	conditionData, err := buildGroupConditionData(map[string]interface{}{
+		"key":         model.Condition_KEY_TAG,
+		"member_type": model.Condition_MEMBER_TYPE_VIRTUALMACHINE,
+		"operator":    model.Condition_OPERATOR_EQUALS,
+		"value":       "web",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ranges, ok := getPolicyGroupIPRanges(testPolicyAnalyzedGroup(ipData("10.0.0.0/24"), conjunctionData(model.ConjunctionOperator_CONJUNCTION_OPERATOR_OR), ipData("10.0.1.5")))
+	if !ok || len(ranges) != 2 {
+		t.Fatalf("Expected group to be resolved to 2 ranges, got %v", ranges)
+	}
+	expected, _ := parseIPRange("10.0.0.0/24")
+	if !ranges[0].contains(expected) || !expected.contains(ranges[0]) {
+		t.Errorf("Unexpected range %v", ranges[0])
+	}
+
+	unresolved := map[string]model.Group{
+		"empty":           testPolicyAnalyzedGroup(),
+		"condition":       testPolicyAnalyzedGroup(ipData("10.0.0.0/24"), conjunctionData(model.ConjunctionOperator_CONJUNCTION_OPERATOR_OR), conditionData),
+		"and conjunction": testPolicyAnalyzedGroup(ipData("10.0.0.0/24"), conjunctionData(model.ConjunctionOperator_CONJUNCTION_OPERATOR_AND), ipData("10.0.1.5")),
+	}
+	for name, group := range unresolved {
+		if _, ok := getPolicyGroupIPRanges(group); ok {
+			t.Errorf("%s: expected group to be compared by path", name)
+		}
+	}
+}
+
+func TestPolicyGroupIPRangesCache(t *testing.T) {
+	calls := make(map[string]int)
+	resolve := func(groupPath string) ([]ipRange, bool) {
+		calls[groupPath]++
+		return testPolicyGroupResolver(groupPath)
+	}
+
+	cache := newPolicyGroupIPRangesCache()
+	for i := 0; i < 2; i++ {
+		// Resolver is created per policy, while cache is shared by provider
+		resolver := cache.resolver(resolve)
+		if ranges, ok := resolver("/infra/domains/default/groups/lab"); !ok || len(ranges) != 1 {
+			t.Errorf("Expected group to be resolved, got %v", ranges)
+		}
+		if _, ok := resolver("/infra/domains/default/groups/web"); ok {
+			t.Errorf("Expected unknown group not to be resolved")
+		}
+	}
+	if calls["/infra/domains/default/groups/lab"] != 1 || calls["/infra/domains/default/groups/web"] != 1 {
+		t.Errorf("Expected each group to be read once, got %v", calls)
+	}
+
+	// Without cache, groups are resolved directly
+	var noCache *policyGroupIPRangesCache
+	if _, ok := noCache.resolver(resolve)("/infra/domains/default/groups/lab"); !ok {
+		t.Errorf("Expected group to be resolved without cache")
+	}
+}
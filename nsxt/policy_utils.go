@@ -158,6 +158,62 @@ func nsxtDomainResourceImporter(d *schema.ResourceData, m interface{}) ([]*schem
 	return []*schema.ResourceData{d}, nil
 }
 
+// Prefix of import ID referring to Manager object that was promoted to policy
+const policyPromotedObjectImportPrefix = "mp:"
+
+// Importer for domain objects that can be promoted from Manager API objects.
+// In addition to regular import IDs, "mp:<manager object ID>" is accepted for
+// objects promoted by NSX. Promotion preserves object ID and places objects in
+// default domain, and realization of the policy object is verified to point
+// back to the Manager object before import.
+func nsxtDomainResourcePromotedImporter(collection string) schema.StateFunc {
+	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		importID := d.Id()
+		if !strings.HasPrefix(importID, policyPromotedObjectImportPrefix) {
+			return nsxtDomainResourceImporter(d, m)
+		}
+
+		if isPolicyGlobalManager(m) {
+			return nil, localManagerOnlyError()
+		}
+
+		mpID := strings.TrimPrefix(importID, policyPromotedObjectImportPrefix)
+		if mpID == "" {
+			return nil, fmt.Errorf("Please provide Manager object ID as an input, for example %s<ID>", policyPromotedObjectImportPrefix)
+		}
+
+		intentPath := fmt.Sprintf("/infra/domains/%s/%s/%s", defaultDomain, collection, mpID)
+		err := verifyPolicyPromotedObject(getPolicyConnector(m), intentPath, mpID)
+		if err != nil {
+			return nil, err
+		}
+
+		d.SetId(mpID)
+		d.Set("domain", defaultDomain)
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+func verifyPolicyPromotedObject(connector *client.RestConnector, intentPath string, mpID string) error {
+	client := realized_state.NewRealizedEntitiesClient(connector)
+	realizationResult, err := client.List(intentPath, nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			return fmt.Errorf("Policy object %s promoted from Manager object %s was not found", intentPath, mpID)
+		}
+		return logAPIError(fmt.Sprintf("Error retrieving realization of %s", intentPath), err)
+	}
+
+	for _, entity := range realizationResult.Results {
+		if entity.RealizationSpecificIdentifier != nil && *entity.RealizationSpecificIdentifier == mpID {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Policy object %s is not realized as Manager object %s, please make sure the object was promoted", intentPath, mpID)
+}
+
 func isPolicyPath(policyPath string) bool {
 	pathSegs := strings.Split(policyPath, "/")
 	if len(pathSegs) < 4 {
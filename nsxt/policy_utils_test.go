@@ -0,0 +1,81 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNsxtDomainResourcePromotedImporter(t *testing.T) {
+	r := resourceNsxtPolicyGroup()
+	importer := nsxtDomainResourcePromotedImporter("groups")
+
+	// Regular import IDs are handled by domain importer
+	d := r.Data(nil)
+	d.SetId("cgw/group1")
+	if _, err := importer(d, nsxtClients{}); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "group1" || d.Get("domain").(string) != "cgw" {
+		t.Errorf("Unexpected import result %s/%s", d.Get("domain"), d.Id())
+	}
+
+	// Promotion is not supported on Global Manager
+	d = r.Data(nil)
+	d.SetId(policyPromotedObjectImportPrefix + "c8b6b0b4-0c0b-4b1e-9a1b-1b0e5b8b6c2d")
+	if _, err := importer(d, nsxtClients{PolicyGlobalManager: true}); err == nil {
+		t.Errorf("Expected error for Global Manager")
+	}
+
+	d = r.Data(nil)
+	d.SetId(policyPromotedObjectImportPrefix)
+	if _, err := importer(d, nsxtClients{}); err == nil {
+		t.Errorf("Expected error for empty Manager object ID")
+	}
+}
+
+func TestNsxtDomainResourcePromotedImporterRealization(t *testing.T) {
+	mpID := "c8b6b0b4-0c0b-4b1e-9a1b-1b0e5b8b6c2d"
+	realizedID := mpID
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/infra/realized-state/realized-entities") {
+			t.Errorf("Unexpected request %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("intent_path") != "/infra/domains/default/groups/"+mpID {
+			t.Errorf("Unexpected intent path %s", r.URL.Query().Get("intent_path"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"result_count": 1, "results": [{"id": "group", "realization_specific_identifier": "%s"}]}`, realizedID)
+	}))
+	defer server.Close()
+
+	r := resourceNsxtPolicyGroup()
+	importer := nsxtDomainResourcePromotedImporter("groups")
+	clients := nsxtClients{
+		Host:             server.URL,
+		PolicyHTTPClient: server.Client(),
+	}
+
+	d := r.Data(nil)
+	d.SetId(policyPromotedObjectImportPrefix + mpID)
+	if _, err := importer(d, clients); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != mpID || d.Get("domain").(string) != "default" {
+		t.Errorf("Unexpected import result %s/%s", d.Get("domain"), d.Id())
+	}
+
+	// Policy object is realized as a different Manager object
+	realizedID = "5a2e3d1c-7f4b-4c1e-8d2a-9b3c4d5e6f70"
+	d = r.Data(nil)
+	d.SetId(policyPromotedObjectImportPrefix + mpID)
+	_, err := importer(d, clients)
+	if err == nil || !strings.Contains(err.Error(), "is not realized as Manager object") {
+		t.Errorf("Expected realization error, got %v", err)
+	}
+}
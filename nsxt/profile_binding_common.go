@@ -0,0 +1,251 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+// Attributes shared by profile binding maps of all types
+type policyProfileBinding struct {
+	DisplayName    *string
+	Description    *string
+	Tags           []model.Tag
+	Path           *string
+	Revision       *int64
+	ProfilePath    *string
+	SequenceNumber *int64
+}
+
+// Profile binding resource, defined by binding map API for specific profile type
+// and parent object (group or gateway)
+type policyProfileBindingResource struct {
+	// Name used in log and error messages
+	name string
+	// Attribute holding parent object path, and its description
+	parentAttribute   string
+	parentDescription string
+	// Description of profile path attribute
+	profileDescription string
+	// Schema for sequence number, nil if not supported by binding map
+	sequenceNumberSchema *schema.Schema
+	// Collection segment in binding path, and example parent path for import
+	importSeparator     string
+	importParentExample string
+	validateParent      func(parentPath string) error
+
+	get    func(connector *client.RestConnector, parentPath string, id string, isGlobalManager bool) (policyProfileBinding, error)
+	patch  func(connector *client.RestConnector, parentPath string, id string, obj policyProfileBinding, isGlobalManager bool) error
+	delete func(connector *client.RestConnector, parentPath string, id string, isGlobalManager bool) error
+}
+
+func (b policyProfileBindingResource) resource() *schema.Resource {
+	resourceSchema := map[string]*schema.Schema{
+		"nsx_id":          getNsxIDSchema(),
+		"path":            getPathSchema(),
+		"display_name":    getDisplayNameSchema(),
+		"description":     getDescriptionSchema(),
+		"revision":        getRevisionSchema(),
+		"tag":             getTagsSchema(),
+		b.parentAttribute: getPolicyPathSchema(true, true, b.parentDescription),
+		"profile_path":    getPolicyPathSchema(true, false, b.profileDescription),
+	}
+	if b.sequenceNumberSchema != nil {
+		resourceSchema["sequence_number"] = b.sequenceNumberSchema
+	}
+
+	return &schema.Resource{
+		Create: b.create,
+		Read:   b.read,
+		Update: b.update,
+		Delete: b.remove,
+		Importer: &schema.ResourceImporter{
+			State: b.importState,
+		},
+
+		Schema: resourceSchema,
+	}
+}
+
+func (b policyProfileBindingResource) existsPartial(parentPath string) func(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	return func(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+		_, err := b.get(connector, parentPath, id, isGlobalManager)
+		if err == nil {
+			return true, nil
+		}
+
+		if isNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, logAPIError(fmt.Sprintf("Error retrieving %s", b.name), err)
+	}
+}
+
+func (b policyProfileBindingResource) doPatch(d *schema.ResourceData, m interface{}, id string) error {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	profilePath := d.Get("profile_path").(string)
+
+	obj := policyProfileBinding{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        getPolicyTagsFromSchema(d),
+		ProfilePath: &profilePath,
+	}
+
+	if b.sequenceNumberSchema != nil {
+		if value, ok := d.GetOkExists("sequence_number"); ok {
+			sequenceNumber := int64(value.(int))
+			obj.SequenceNumber = &sequenceNumber
+		}
+	}
+
+	if len(d.Id()) > 0 {
+		// This is update flow
+		revision := int64(d.Get("revision").(int))
+		obj.Revision = &revision
+	}
+
+	log.Printf("[INFO] Patching %s with ID %s", b.name, id)
+	return b.patch(getPolicyConnector(m), d.Get(b.parentAttribute).(string), id, obj, isPolicyGlobalManager(m))
+}
+
+func (b policyProfileBindingResource) create(d *schema.ResourceData, m interface{}) error {
+	parentPath := d.Get(b.parentAttribute).(string)
+	if err := b.validateParent(parentPath); err != nil {
+		return err
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, b.existsPartial(parentPath))
+	if err != nil {
+		return err
+	}
+
+	err = b.doPatch(d, m, id)
+	if err != nil {
+		return handleCreateError(b.name, id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return b.read(d, m)
+}
+
+func (b policyProfileBindingResource) read(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining %s ID", b.name)
+	}
+
+	obj, err := b.get(connector, d.Get(b.parentAttribute).(string), id, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleReadError(d, b.name, id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("profile_path", obj.ProfilePath)
+	if b.sequenceNumberSchema != nil {
+		d.Set("sequence_number", obj.SequenceNumber)
+	}
+
+	return nil
+}
+
+func (b policyProfileBindingResource) update(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining %s ID", b.name)
+	}
+
+	err := b.doPatch(d, m, id)
+	if err != nil {
+		return handleUpdateError(b.name, id, err)
+	}
+
+	return b.read(d, m)
+}
+
+func (b policyProfileBindingResource) remove(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining %s ID", b.name)
+	}
+
+	err := b.delete(getPolicyConnector(m), d.Get(b.parentAttribute).(string), id, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleDeleteError(b.name, id, err)
+	}
+
+	return nil
+}
+
+func (b policyProfileBindingResource) importState(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importPath := d.Id()
+	idx := strings.LastIndex(importPath, b.importSeparator)
+	if idx <= 0 {
+		return nil, fmt.Errorf("Please provide binding path as an input, for example %s%s<binding-id>", b.importParentExample, b.importSeparator)
+	}
+
+	parentPath := importPath[:idx]
+	if err := b.validateParent(parentPath); err != nil {
+		return nil, err
+	}
+
+	d.SetId(importPath[idx+len(b.importSeparator):])
+	d.Set(b.parentAttribute, parentPath)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func validatePolicyGroupPath(groupPath string) error {
+	_, _, err := parsePolicyGroupPath(groupPath)
+	return err
+}
+
+// Parse path of gateway, or of gateway locale service
+func parseGatewayOrLocaleServicePolicyPath(path string) (bool, string, string, error) {
+	// sample paths look like "/infra/tier-0s/mytier0gw" or
+	// "/infra/tier-1s/mytier1gw/locale-services/default"
+	segs := strings.Split(path, "/")
+	if len(segs) != 4 && !(len(segs) == 6 && segs[4] == "locale-services" && segs[5] != "") {
+		return false, "", "", fmt.Errorf("Expected gateway or gateway locale service path, got %s", path)
+	}
+	if (segs[2] == "tier-0s" || segs[2] == "tier-1s") && segs[3] != "" {
+		localeServiceID := ""
+		if len(segs) == 6 {
+			localeServiceID = segs[5]
+		}
+		return segs[2] == "tier-0s", segs[3], localeServiceID, nil
+	}
+
+	return false, "", "", fmt.Errorf("Expected gateway or gateway locale service path, got %s", path)
+}
+
+func validatePolicyGatewayPath(gwPath string) error {
+	_, _, localeServiceID, err := parseGatewayOrLocaleServicePolicyPath(gwPath)
+	if err == nil && localeServiceID != "" {
+		return fmt.Errorf("Expected gateway path, got %s", gwPath)
+	}
+	return err
+}
+
+func validatePolicyGatewayOrLocaleServicePath(path string) error {
+	_, _, _, err := parseGatewayOrLocaleServicePolicyPath(path)
+	return err
+}
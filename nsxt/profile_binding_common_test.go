@@ -0,0 +1,44 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+)
+
+func TestParseGatewayOrLocaleServicePolicyPath(t *testing.T) {
+	cases := []struct {
+		path            string
+		isT0            bool
+		gwID            string
+		localeServiceID string
+	}{
+		{"/infra/tier-0s/gw0", true, "gw0", ""},
+		{"/infra/tier-1s/gw1", false, "gw1", ""},
+		{"/global-infra/tier-1s/gw1", false, "gw1", ""},
+		{"/infra/tier-0s/gw0/locale-services/default", true, "gw0", "default"},
+		{"/global-infra/tier-1s/gw1/locale-services/site1", false, "gw1", "site1"},
+	}
+
+	for _, c := range cases {
+		isT0, gwID, localeServiceID, err := parseGatewayOrLocaleServicePolicyPath(c.path)
+		if err != nil {
+			t.Errorf("Unexpected error for %s: %v", c.path, err)
+			continue
+		}
+		if isT0 != c.isT0 || gwID != c.gwID || localeServiceID != c.localeServiceID {
+			t.Errorf("Path %s: got %v/%s/%s", c.path, isT0, gwID, localeServiceID)
+		}
+	}
+
+	for _, path := range []string{"/infra/segments/seg1", "/infra/tier-1s/", "/infra/tier-1s/gw1/locale-services/", "/infra/tier-1s/gw1/interfaces/if1"} {
+		if _, _, _, err := parseGatewayOrLocaleServicePolicyPath(path); err == nil {
+			t.Errorf("Expected error for %s", path)
+		}
+	}
+
+	if err := validatePolicyGatewayPath("/infra/tier-1s/gw1/locale-services/default"); err == nil {
+		t.Errorf("Expected error for locale service path")
+	}
+}
@@ -37,6 +37,11 @@ type commonProviderConfig struct {
 	MinRetryInterval       int
 	MaxRetryInterval       int
 	RetryStatusCodes       []int
+	CheckRealization       bool
+	OnConflict             string
+	// Plan time analysis of firewall rules
+	FirewallRuleAnalysis                   string
+	FirewallRuleAnalysisApprovedCategories []string
 }
 
 type nsxtClients struct {
@@ -55,6 +60,14 @@ type nsxtClients struct {
 	Host                   string
 	PolicyEnforcementPoint string
 	PolicyGlobalManager    bool
+	// Expected revision for policy object being updated, if any
+	PolicyRevisionGuard *policyRevisionGuard
+	// Settings from named profile in provider config file, if any
+	ProviderProfile providerProfile
+	// External helper supplying credentials, if configured
+	CredentialsProcess *credentialsProcess
+	// Groups resolved by firewall rule analysis
+	PolicyGroupIPRanges *policyGroupIPRangesCache
 }
 
 // Provider for VMWare NSX-T
@@ -149,6 +162,7 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Description: "Long-living API token for VMC authorization",
 				DefaultFunc: schema.EnvDefaultFunc("NSXT_VMC_TOKEN", nil),
+				Sensitive:   true,
 			},
 			"vmc_auth_mode": {
 				Type:         schema.TypeString,
@@ -174,6 +188,7 @@ func Provider() *schema.Provider {
 				Optional:      true,
 				Description:   "license keys",
 				ConflictsWith: []string{"vmc_token"},
+				Sensitive:     true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 					ValidateFunc: validation.StringMatch(
@@ -193,6 +208,7 @@ func Provider() *schema.Provider {
 				Description: "Client certificate key passed as string",
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("NSXT_CLIENT_AUTH_KEY", nil),
+				Sensitive:   true,
 			},
 			"ca": {
 				Type:        schema.TypeString,
@@ -200,184 +216,271 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("NSXT_CA", nil),
 			},
+			"check_realization": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Check realization state of policy resources after create and update, and fail on realization errors",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_CHECK_REALIZATION", false),
+			},
+			"on_conflict": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Behavior when policy object was modified outside Terraform since last refresh: overwrite, retry or fail",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_ON_CONFLICT", policyOnConflictOverwrite),
+				ValidateFunc: validation.StringInSlice(policyOnConflictValues, false),
+			},
+			"firewall_rule_analysis": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Analyze planned security and gateway policy rules for shadowed, duplicate and overly broad rules: off, warn or fail",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_FIREWALL_RULE_ANALYSIS", policyRuleAnalysisOff),
+				ValidateFunc: validation.StringInSlice(policyRuleAnalysisValues, false),
+			},
+			"firewall_rule_analysis_approved_categories": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Policy categories where rules allowing ANY source, destination and service are approved",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"credentials_process": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Command that returns credentials in JSON format. Invoked again when credentials expire",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_CREDENTIALS_PROCESS", nil),
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of profile in provider config file to take connection settings from",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_PROFILE", nil),
+			},
+			"config_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path to provider config file with named profiles",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_CONFIG_FILE", defaultProviderConfigFile),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"nsxt_provider_info":                    dataSourceNsxtProviderInfo(),
-			"nsxt_transport_zone":                   dataSourceNsxtTransportZone(),
-			"nsxt_switching_profile":                dataSourceNsxtSwitchingProfile(),
-			"nsxt_logical_tier0_router":             dataSourceNsxtLogicalTier0Router(),
-			"nsxt_logical_tier1_router":             dataSourceNsxtLogicalTier1Router(),
-			"nsxt_mac_pool":                         dataSourceNsxtMacPool(),
-			"nsxt_ns_group":                         dataSourceNsxtNsGroup(),
-			"nsxt_ns_groups":                        dataSourceNsxtNsGroups(),
-			"nsxt_ns_service":                       dataSourceNsxtNsService(),
-			"nsxt_ns_services":                      dataSourceNsxtNsServices(),
-			"nsxt_edge_cluster":                     dataSourceNsxtEdgeCluster(),
-			"nsxt_certificate":                      dataSourceNsxtCertificate(),
-			"nsxt_ip_pool":                          dataSourceNsxtIPPool(),
-			"nsxt_firewall_section":                 dataSourceNsxtFirewallSection(),
-			"nsxt_management_cluster":               dataSourceNsxtManagementCluster(),
-			"nsxt_policy_edge_cluster":              dataSourceNsxtPolicyEdgeCluster(),
-			"nsxt_policy_edge_node":                 dataSourceNsxtPolicyEdgeNode(),
-			"nsxt_policy_tier0_gateway":             dataSourceNsxtPolicyTier0Gateway(),
-			"nsxt_policy_tier1_gateway":             dataSourceNsxtPolicyTier1Gateway(),
-			"nsxt_policy_service":                   dataSourceNsxtPolicyService(),
-			"nsxt_policy_realization_info":          dataSourceNsxtPolicyRealizationInfo(),
-			"nsxt_policy_segment_realization":       dataSourceNsxtPolicySegmentRealization(),
-			"nsxt_policy_transport_zone":            dataSourceNsxtPolicyTransportZone(),
-			"nsxt_policy_ip_discovery_profile":      dataSourceNsxtPolicyIPDiscoveryProfile(),
-			"nsxt_policy_spoofguard_profile":        dataSourceNsxtPolicySpoofGuardProfile(),
-			"nsxt_policy_qos_profile":               dataSourceNsxtPolicyQosProfile(),
-			"nsxt_policy_ipv6_ndra_profile":         dataSourceNsxtPolicyIpv6NdraProfile(),
-			"nsxt_policy_ipv6_dad_profile":          dataSourceNsxtPolicyIpv6DadProfile(),
-			"nsxt_policy_gateway_qos_profile":       dataSourceNsxtPolicyGatewayQosProfile(),
-			"nsxt_policy_segment_security_profile":  dataSourceNsxtPolicySegmentSecurityProfile(),
-			"nsxt_policy_mac_discovery_profile":     dataSourceNsxtPolicyMacDiscoveryProfile(),
-			"nsxt_policy_vm":                        dataSourceNsxtPolicyVM(),
-			"nsxt_policy_vms":                       dataSourceNsxtPolicyVMs(),
-			"nsxt_policy_lb_app_profile":            dataSourceNsxtPolicyLBAppProfile(),
-			"nsxt_policy_lb_client_ssl_profile":     dataSourceNsxtPolicyLBClientSslProfile(),
-			"nsxt_policy_lb_server_ssl_profile":     dataSourceNsxtPolicyLBServerSslProfile(),
-			"nsxt_policy_lb_monitor":                dataSourceNsxtPolicyLBMonitor(),
-			"nsxt_policy_certificate":               dataSourceNsxtPolicyCertificate(),
-			"nsxt_policy_lb_persistence_profile":    dataSourceNsxtPolicyLbPersistenceProfile(),
-			"nsxt_policy_vni_pool":                  dataSourceNsxtPolicyVniPool(),
-			"nsxt_policy_ip_block":                  dataSourceNsxtPolicyIPBlock(),
-			"nsxt_policy_ip_pool":                   dataSourceNsxtPolicyIPPool(),
-			"nsxt_policy_site":                      dataSourceNsxtPolicySite(),
-			"nsxt_policy_gateway_policy":            dataSourceNsxtPolicyGatewayPolicy(),
-			"nsxt_policy_security_policy":           dataSourceNsxtPolicySecurityPolicy(),
-			"nsxt_policy_group":                     dataSourceNsxtPolicyGroup(),
-			"nsxt_policy_context_profile":           dataSourceNsxtPolicyContextProfile(),
-			"nsxt_policy_dhcp_server":               dataSourceNsxtPolicyDhcpServer(),
-			"nsxt_policy_bfd_profile":               dataSourceNsxtPolicyBfdProfile(),
-			"nsxt_policy_intrusion_service_profile": dataSourceNsxtPolicyIntrusionServiceProfile(),
-			"nsxt_policy_lb_service":                dataSourceNsxtPolicyLbService(),
+			"nsxt_provider_info":                         dataSourceNsxtProviderInfo(),
+			"nsxt_transport_zone":                        dataSourceNsxtTransportZone(),
+			"nsxt_switching_profile":                     dataSourceNsxtSwitchingProfile(),
+			"nsxt_logical_tier0_router":                  dataSourceNsxtLogicalTier0Router(),
+			"nsxt_logical_tier1_router":                  dataSourceNsxtLogicalTier1Router(),
+			"nsxt_mac_pool":                              dataSourceNsxtMacPool(),
+			"nsxt_ns_group":                              dataSourceNsxtNsGroup(),
+			"nsxt_ns_groups":                             dataSourceNsxtNsGroups(),
+			"nsxt_ns_service":                            dataSourceNsxtNsService(),
+			"nsxt_ns_services":                           dataSourceNsxtNsServices(),
+			"nsxt_edge_cluster":                          dataSourceNsxtEdgeCluster(),
+			"nsxt_certificate":                           dataSourceNsxtCertificate(),
+			"nsxt_ip_pool":                               dataSourceNsxtIPPool(),
+			"nsxt_firewall_section":                      dataSourceNsxtFirewallSection(),
+			"nsxt_management_cluster":                    dataSourceNsxtManagementCluster(),
+			"nsxt_policy_edge_cluster":                   dataSourceNsxtPolicyEdgeCluster(),
+			"nsxt_policy_edge_node":                      dataSourceNsxtPolicyEdgeNode(),
+			"nsxt_policy_tier0_gateway":                  dataSourceNsxtPolicyTier0Gateway(),
+			"nsxt_policy_tier1_gateway":                  dataSourceNsxtPolicyTier1Gateway(),
+			"nsxt_policy_service":                        dataSourceNsxtPolicyService(),
+			"nsxt_policy_realization_info":               dataSourceNsxtPolicyRealizationInfo(),
+			"nsxt_policy_segment_realization":            dataSourceNsxtPolicySegmentRealization(),
+			"nsxt_policy_transport_zone":                 dataSourceNsxtPolicyTransportZone(),
+			"nsxt_policy_ip_discovery_profile":           dataSourceNsxtPolicyIPDiscoveryProfile(),
+			"nsxt_policy_spoofguard_profile":             dataSourceNsxtPolicySpoofGuardProfile(),
+			"nsxt_policy_qos_profile":                    dataSourceNsxtPolicyQosProfile(),
+			"nsxt_policy_ipv6_ndra_profile":              dataSourceNsxtPolicyIpv6NdraProfile(),
+			"nsxt_policy_ipv6_dad_profile":               dataSourceNsxtPolicyIpv6DadProfile(),
+			"nsxt_policy_gateway_qos_profile":            dataSourceNsxtPolicyGatewayQosProfile(),
+			"nsxt_policy_segment_security_profile":       dataSourceNsxtPolicySegmentSecurityProfile(),
+			"nsxt_policy_mac_discovery_profile":          dataSourceNsxtPolicyMacDiscoveryProfile(),
+			"nsxt_policy_vm":                             dataSourceNsxtPolicyVM(),
+			"nsxt_policy_vms":                            dataSourceNsxtPolicyVMs(),
+			"nsxt_policy_lb_app_profile":                 dataSourceNsxtPolicyLBAppProfile(),
+			"nsxt_policy_lb_client_ssl_profile":          dataSourceNsxtPolicyLBClientSslProfile(),
+			"nsxt_policy_lb_server_ssl_profile":          dataSourceNsxtPolicyLBServerSslProfile(),
+			"nsxt_policy_lb_monitor":                     dataSourceNsxtPolicyLBMonitor(),
+			"nsxt_policy_certificate":                    dataSourceNsxtPolicyCertificate(),
+			"nsxt_policy_lb_persistence_profile":         dataSourceNsxtPolicyLbPersistenceProfile(),
+			"nsxt_policy_vni_pool":                       dataSourceNsxtPolicyVniPool(),
+			"nsxt_policy_ip_block":                       dataSourceNsxtPolicyIPBlock(),
+			"nsxt_policy_ip_pool":                        dataSourceNsxtPolicyIPPool(),
+			"nsxt_policy_site":                           dataSourceNsxtPolicySite(),
+			"nsxt_policy_gateway_policy":                 dataSourceNsxtPolicyGatewayPolicy(),
+			"nsxt_policy_security_policy":                dataSourceNsxtPolicySecurityPolicy(),
+			"nsxt_policy_group":                          dataSourceNsxtPolicyGroup(),
+			"nsxt_policy_context_profile":                dataSourceNsxtPolicyContextProfile(),
+			"nsxt_policy_dhcp_server":                    dataSourceNsxtPolicyDhcpServer(),
+			"nsxt_policy_bfd_profile":                    dataSourceNsxtPolicyBfdProfile(),
+			"nsxt_policy_intrusion_service_profile":      dataSourceNsxtPolicyIntrusionServiceProfile(),
+			"nsxt_policy_lb_service":                     dataSourceNsxtPolicyLbService(),
+			"nsxt_policy_firewall_exclude_list":          dataSourceNsxtPolicyFirewallExcludeList(),
+			"nsxt_policy_url_categories":                 dataSourceNsxtPolicyURLCategories(),
+			"nsxt_policy_url_reputation_severities":      dataSourceNsxtPolicyURLReputationSeverities(),
+			"nsxt_policy_tls_inspection_state":           dataSourceNsxtPolicyTLSInspectionState(),
+			"nsxt_policy_partner_service":                dataSourceNsxtPolicyPartnerService(),
+			"nsxt_policy_intrusion_service_events":       dataSourceNsxtPolicyIntrusionServiceEvents(),
+			"nsxt_policy_intrusion_service_summary":      dataSourceNsxtPolicyIntrusionServiceSummary(),
+			"nsxt_policy_intrusion_service_affected_vms": dataSourceNsxtPolicyIntrusionServiceAffectedVms(),
+			"nsxt_policy_intrusion_service_affected_ips": dataSourceNsxtPolicyIntrusionServiceAffectedIps(),
+			"nsxt_policy_service_profiles":               dataSourceNsxtPolicyServiceProfiles(),
+			"nsxt_policy_group_members":                  dataSourceNsxtPolicyGroupMembers(),
+			"nsxt_policy_group_associations":             dataSourceNsxtPolicyGroupAssociations(),
+			"nsxt_policy_firewall_rule_statistics":       dataSourceNsxtPolicyFirewallRuleStatistics(),
+			"nsxt_policy_service_references":             dataSourceNsxtPolicyServiceReferences(),
 		},
 
-		ResourcesMap: map[string]*schema.Resource{
-			"nsxt_dhcp_relay_profile":                      resourceNsxtDhcpRelayProfile(),
-			"nsxt_dhcp_relay_service":                      resourceNsxtDhcpRelayService(),
-			"nsxt_dhcp_server_profile":                     resourceNsxtDhcpServerProfile(),
-			"nsxt_logical_dhcp_server":                     resourceNsxtLogicalDhcpServer(),
-			"nsxt_dhcp_server_ip_pool":                     resourceNsxtDhcpServerIPPool(),
-			"nsxt_logical_switch":                          resourceNsxtLogicalSwitch(),
-			"nsxt_vlan_logical_switch":                     resourceNsxtVlanLogicalSwitch(),
-			"nsxt_logical_dhcp_port":                       resourceNsxtLogicalDhcpPort(),
-			"nsxt_logical_port":                            resourceNsxtLogicalPort(),
-			"nsxt_logical_tier0_router":                    resourceNsxtLogicalTier0Router(),
-			"nsxt_logical_tier1_router":                    resourceNsxtLogicalTier1Router(),
-			"nsxt_logical_router_centralized_service_port": resourceNsxtLogicalRouterCentralizedServicePort(),
-			"nsxt_logical_router_downlink_port":            resourceNsxtLogicalRouterDownLinkPort(),
-			"nsxt_logical_router_link_port_on_tier0":       resourceNsxtLogicalRouterLinkPortOnTier0(),
-			"nsxt_logical_router_link_port_on_tier1":       resourceNsxtLogicalRouterLinkPortOnTier1(),
-			"nsxt_ip_discovery_switching_profile":          resourceNsxtIPDiscoverySwitchingProfile(),
-			"nsxt_mac_management_switching_profile":        resourceNsxtMacManagementSwitchingProfile(),
-			"nsxt_qos_switching_profile":                   resourceNsxtQosSwitchingProfile(),
-			"nsxt_spoofguard_switching_profile":            resourceNsxtSpoofGuardSwitchingProfile(),
-			"nsxt_switch_security_switching_profile":       resourceNsxtSwitchSecuritySwitchingProfile(),
-			"nsxt_l4_port_set_ns_service":                  resourceNsxtL4PortSetNsService(),
-			"nsxt_algorithm_type_ns_service":               resourceNsxtAlgorithmTypeNsService(),
-			"nsxt_icmp_type_ns_service":                    resourceNsxtIcmpTypeNsService(),
-			"nsxt_igmp_type_ns_service":                    resourceNsxtIgmpTypeNsService(),
-			"nsxt_ether_type_ns_service":                   resourceNsxtEtherTypeNsService(),
-			"nsxt_ip_protocol_ns_service":                  resourceNsxtIPProtocolNsService(),
-			"nsxt_ns_service_group":                        resourceNsxtNsServiceGroup(),
-			"nsxt_ns_group":                                resourceNsxtNsGroup(),
-			"nsxt_firewall_section":                        resourceNsxtFirewallSection(),
-			"nsxt_nat_rule":                                resourceNsxtNatRule(),
-			"nsxt_ip_block":                                resourceNsxtIPBlock(),
-			"nsxt_ip_block_subnet":                         resourceNsxtIPBlockSubnet(),
-			"nsxt_ip_pool":                                 resourceNsxtIPPool(),
-			"nsxt_ip_pool_allocation_ip_address":           resourceNsxtIPPoolAllocationIPAddress(),
-			"nsxt_ip_set":                                  resourceNsxtIPSet(),
-			"nsxt_static_route":                            resourceNsxtStaticRoute(),
-			"nsxt_vm_tags":                                 resourceNsxtVMTags(),
-			"nsxt_lb_icmp_monitor":                         resourceNsxtLbIcmpMonitor(),
-			"nsxt_lb_tcp_monitor":                          resourceNsxtLbTCPMonitor(),
-			"nsxt_lb_udp_monitor":                          resourceNsxtLbUDPMonitor(),
-			"nsxt_lb_http_monitor":                         resourceNsxtLbHTTPMonitor(),
-			"nsxt_lb_https_monitor":                        resourceNsxtLbHTTPSMonitor(),
-			"nsxt_lb_passive_monitor":                      resourceNsxtLbPassiveMonitor(),
-			"nsxt_lb_pool":                                 resourceNsxtLbPool(),
-			"nsxt_lb_tcp_virtual_server":                   resourceNsxtLbTCPVirtualServer(),
-			"nsxt_lb_udp_virtual_server":                   resourceNsxtLbUDPVirtualServer(),
-			"nsxt_lb_http_virtual_server":                  resourceNsxtLbHTTPVirtualServer(),
-			"nsxt_lb_http_forwarding_rule":                 resourceNsxtLbHTTPForwardingRule(),
-			"nsxt_lb_http_request_rewrite_rule":            resourceNsxtLbHTTPRequestRewriteRule(),
-			"nsxt_lb_http_response_rewrite_rule":           resourceNsxtLbHTTPResponseRewriteRule(),
-			"nsxt_lb_cookie_persistence_profile":           resourceNsxtLbCookiePersistenceProfile(),
-			"nsxt_lb_source_ip_persistence_profile":        resourceNsxtLbSourceIPPersistenceProfile(),
-			"nsxt_lb_client_ssl_profile":                   resourceNsxtLbClientSslProfile(),
-			"nsxt_lb_server_ssl_profile":                   resourceNsxtLbServerSslProfile(),
-			"nsxt_lb_service":                              resourceNsxtLbService(),
-			"nsxt_lb_fast_tcp_application_profile":         resourceNsxtLbFastTCPApplicationProfile(),
-			"nsxt_lb_fast_udp_application_profile":         resourceNsxtLbFastUDPApplicationProfile(),
-			"nsxt_lb_http_application_profile":             resourceNsxtLbHTTPApplicationProfile(),
-			"nsxt_policy_tier1_gateway":                    resourceNsxtPolicyTier1Gateway(),
-			"nsxt_policy_tier1_gateway_interface":          resourceNsxtPolicyTier1GatewayInterface(),
-			"nsxt_policy_tier0_gateway":                    resourceNsxtPolicyTier0Gateway(),
-			"nsxt_policy_tier0_gateway_interface":          resourceNsxtPolicyTier0GatewayInterface(),
-			"nsxt_policy_tier0_gateway_ha_vip_config":      resourceNsxtPolicyTier0GatewayHAVipConfig(),
-			"nsxt_policy_group":                            resourceNsxtPolicyGroup(),
-			"nsxt_policy_domain":                           resourceNsxtPolicyDomain(),
-			"nsxt_policy_security_policy":                  resourceNsxtPolicySecurityPolicy(),
-			"nsxt_policy_service":                          resourceNsxtPolicyService(),
-			"nsxt_policy_gateway_policy":                   resourceNsxtPolicyGatewayPolicy(),
-			"nsxt_policy_predefined_gateway_policy":        resourceNsxtPolicyPredefinedGatewayPolicy(),
-			"nsxt_policy_predefined_security_policy":       resourceNsxtPolicyPredefinedSecurityPolicy(),
-			"nsxt_policy_segment":                          resourceNsxtPolicySegment(),
-			"nsxt_policy_vlan_segment":                     resourceNsxtPolicyVlanSegment(),
-			"nsxt_policy_fixed_segment":                    resourceNsxtPolicyFixedSegment(),
-			"nsxt_policy_static_route":                     resourceNsxtPolicyStaticRoute(),
-			"nsxt_policy_gateway_prefix_list":              resourceNsxtPolicyGatewayPrefixList(),
-			"nsxt_policy_vm_tags":                          resourceNsxtPolicyVMTags(),
-			"nsxt_policy_nat_rule":                         resourceNsxtPolicyNATRule(),
-			"nsxt_policy_ip_block":                         resourceNsxtPolicyIPBlock(),
-			"nsxt_policy_lb_pool":                          resourceNsxtPolicyLBPool(),
-			"nsxt_policy_ip_pool":                          resourceNsxtPolicyIPPool(),
-			"nsxt_policy_ip_pool_block_subnet":             resourceNsxtPolicyIPPoolBlockSubnet(),
-			"nsxt_policy_ip_pool_static_subnet":            resourceNsxtPolicyIPPoolStaticSubnet(),
-			"nsxt_policy_lb_service":                       resourceNsxtPolicyLBService(),
-			"nsxt_policy_lb_virtual_server":                resourceNsxtPolicyLBVirtualServer(),
-			"nsxt_policy_ip_address_allocation":            resourceNsxtPolicyIPAddressAllocation(),
-			"nsxt_policy_bgp_neighbor":                     resourceNsxtPolicyBgpNeighbor(),
-			"nsxt_policy_bgp_config":                       resourceNsxtPolicyBgpConfig(),
-			"nsxt_policy_dhcp_relay":                       resourceNsxtPolicyDhcpRelayConfig(),
-			"nsxt_policy_dhcp_server":                      resourceNsxtPolicyDhcpServer(),
-			"nsxt_policy_context_profile":                  resourceNsxtPolicyContextProfile(),
-			"nsxt_policy_dhcp_v4_static_binding":           resourceNsxtPolicyDhcpV4StaticBinding(),
-			"nsxt_policy_dhcp_v6_static_binding":           resourceNsxtPolicyDhcpV6StaticBinding(),
-			"nsxt_policy_dns_forwarder_zone":               resourceNsxtPolicyDNSForwarderZone(),
-			"nsxt_policy_gateway_dns_forwarder":            resourceNsxtPolicyGatewayDNSForwarder(),
-			"nsxt_policy_gateway_community_list":           resourceNsxtPolicyGatewayCommunityList(),
-			"nsxt_policy_gateway_route_map":                resourceNsxtPolicyGatewayRouteMap(),
-			"nsxt_policy_intrusion_service_policy":         resourceNsxtPolicyIntrusionServicePolicy(),
-			"nsxt_policy_static_route_bfd_peer":            resourceNsxtPolicyStaticRouteBfdPeer(),
-			"nsxt_policy_intrusion_service_profile":        resourceNsxtPolicyIntrusionServiceProfile(),
-			"nsxt_policy_evpn_tenant":                      resourceNsxtPolicyEvpnTenant(),
-			"nsxt_policy_evpn_config":                      resourceNsxtPolicyEvpnConfig(),
-			"nsxt_policy_evpn_tunnel_endpoint":             resourceNsxtPolicyEvpnTunnelEndpoint(),
-			"nsxt_policy_qos_profile":                      resourceNsxtPolicyQosProfile(),
-			"nsxt_policy_ospf_config":                      resourceNsxtPolicyOspfConfig(),
-			"nsxt_policy_ospf_area":                        resourceNsxtPolicyOspfArea(),
-			"nsxt_policy_gateway_redistribution_config":    resourceNsxtPolicyGatewayRedistributionConfig(),
-			"nsxt_policy_mac_discovery_profile":            resourceNsxtPolicyMacDiscoveryProfile(),
-			"nsxt_policy_ipsec_vpn_ike_profile":            resourceNsxtPolicyIPSecVpnIkeProfile(),
-			"nsxt_policy_ipsec_vpn_tunnel_profile":         resourceNsxtPolicyIPSecVpnTunnelProfile(),
-			"nsxt_policy_ipsec_vpn_dpd_profile":            resourceNsxtPolicyIPSecVpnDpdProfile(),
-		},
+		ResourcesMap: withPolicyRealizationCheck(withPolicyConflictCheck(map[string]*schema.Resource{
+			"nsxt_dhcp_relay_profile":                                    resourceNsxtDhcpRelayProfile(),
+			"nsxt_dhcp_relay_service":                                    resourceNsxtDhcpRelayService(),
+			"nsxt_dhcp_server_profile":                                   resourceNsxtDhcpServerProfile(),
+			"nsxt_logical_dhcp_server":                                   resourceNsxtLogicalDhcpServer(),
+			"nsxt_dhcp_server_ip_pool":                                   resourceNsxtDhcpServerIPPool(),
+			"nsxt_logical_switch":                                        resourceNsxtLogicalSwitch(),
+			"nsxt_vlan_logical_switch":                                   resourceNsxtVlanLogicalSwitch(),
+			"nsxt_logical_dhcp_port":                                     resourceNsxtLogicalDhcpPort(),
+			"nsxt_logical_port":                                          resourceNsxtLogicalPort(),
+			"nsxt_logical_tier0_router":                                  resourceNsxtLogicalTier0Router(),
+			"nsxt_logical_tier1_router":                                  resourceNsxtLogicalTier1Router(),
+			"nsxt_logical_router_centralized_service_port":               resourceNsxtLogicalRouterCentralizedServicePort(),
+			"nsxt_logical_router_downlink_port":                          resourceNsxtLogicalRouterDownLinkPort(),
+			"nsxt_logical_router_link_port_on_tier0":                     resourceNsxtLogicalRouterLinkPortOnTier0(),
+			"nsxt_logical_router_link_port_on_tier1":                     resourceNsxtLogicalRouterLinkPortOnTier1(),
+			"nsxt_ip_discovery_switching_profile":                        resourceNsxtIPDiscoverySwitchingProfile(),
+			"nsxt_mac_management_switching_profile":                      resourceNsxtMacManagementSwitchingProfile(),
+			"nsxt_qos_switching_profile":                                 resourceNsxtQosSwitchingProfile(),
+			"nsxt_spoofguard_switching_profile":                          resourceNsxtSpoofGuardSwitchingProfile(),
+			"nsxt_switch_security_switching_profile":                     resourceNsxtSwitchSecuritySwitchingProfile(),
+			"nsxt_l4_port_set_ns_service":                                resourceNsxtL4PortSetNsService(),
+			"nsxt_algorithm_type_ns_service":                             resourceNsxtAlgorithmTypeNsService(),
+			"nsxt_icmp_type_ns_service":                                  resourceNsxtIcmpTypeNsService(),
+			"nsxt_igmp_type_ns_service":                                  resourceNsxtIgmpTypeNsService(),
+			"nsxt_ether_type_ns_service":                                 resourceNsxtEtherTypeNsService(),
+			"nsxt_ip_protocol_ns_service":                                resourceNsxtIPProtocolNsService(),
+			"nsxt_ns_service_group":                                      resourceNsxtNsServiceGroup(),
+			"nsxt_ns_group":                                              resourceNsxtNsGroup(),
+			"nsxt_firewall_section":                                      resourceNsxtFirewallSection(),
+			"nsxt_nat_rule":                                              resourceNsxtNatRule(),
+			"nsxt_ip_block":                                              resourceNsxtIPBlock(),
+			"nsxt_ip_block_subnet":                                       resourceNsxtIPBlockSubnet(),
+			"nsxt_ip_pool":                                               resourceNsxtIPPool(),
+			"nsxt_ip_pool_allocation_ip_address":                         resourceNsxtIPPoolAllocationIPAddress(),
+			"nsxt_ip_set":                                                resourceNsxtIPSet(),
+			"nsxt_static_route":                                          resourceNsxtStaticRoute(),
+			"nsxt_vm_tags":                                               resourceNsxtVMTags(),
+			"nsxt_lb_icmp_monitor":                                       resourceNsxtLbIcmpMonitor(),
+			"nsxt_lb_tcp_monitor":                                        resourceNsxtLbTCPMonitor(),
+			"nsxt_lb_udp_monitor":                                        resourceNsxtLbUDPMonitor(),
+			"nsxt_lb_http_monitor":                                       resourceNsxtLbHTTPMonitor(),
+			"nsxt_lb_https_monitor":                                      resourceNsxtLbHTTPSMonitor(),
+			"nsxt_lb_passive_monitor":                                    resourceNsxtLbPassiveMonitor(),
+			"nsxt_lb_pool":                                               resourceNsxtLbPool(),
+			"nsxt_lb_tcp_virtual_server":                                 resourceNsxtLbTCPVirtualServer(),
+			"nsxt_lb_udp_virtual_server":                                 resourceNsxtLbUDPVirtualServer(),
+			"nsxt_lb_http_virtual_server":                                resourceNsxtLbHTTPVirtualServer(),
+			"nsxt_lb_http_forwarding_rule":                               resourceNsxtLbHTTPForwardingRule(),
+			"nsxt_lb_http_request_rewrite_rule":                          resourceNsxtLbHTTPRequestRewriteRule(),
+			"nsxt_lb_http_response_rewrite_rule":                         resourceNsxtLbHTTPResponseRewriteRule(),
+			"nsxt_lb_cookie_persistence_profile":                         resourceNsxtLbCookiePersistenceProfile(),
+			"nsxt_lb_source_ip_persistence_profile":                      resourceNsxtLbSourceIPPersistenceProfile(),
+			"nsxt_lb_client_ssl_profile":                                 resourceNsxtLbClientSslProfile(),
+			"nsxt_lb_server_ssl_profile":                                 resourceNsxtLbServerSslProfile(),
+			"nsxt_lb_service":                                            resourceNsxtLbService(),
+			"nsxt_lb_fast_tcp_application_profile":                       resourceNsxtLbFastTCPApplicationProfile(),
+			"nsxt_lb_fast_udp_application_profile":                       resourceNsxtLbFastUDPApplicationProfile(),
+			"nsxt_lb_http_application_profile":                           resourceNsxtLbHTTPApplicationProfile(),
+			"nsxt_policy_tier1_gateway":                                  resourceNsxtPolicyTier1Gateway(),
+			"nsxt_policy_tier1_gateway_interface":                        resourceNsxtPolicyTier1GatewayInterface(),
+			"nsxt_policy_tier0_gateway":                                  resourceNsxtPolicyTier0Gateway(),
+			"nsxt_policy_tier0_gateway_interface":                        resourceNsxtPolicyTier0GatewayInterface(),
+			"nsxt_policy_tier0_gateway_ha_vip_config":                    resourceNsxtPolicyTier0GatewayHAVipConfig(),
+			"nsxt_policy_group":                                          resourceNsxtPolicyGroup(),
+			"nsxt_policy_domain":                                         resourceNsxtPolicyDomain(),
+			"nsxt_policy_security_policy":                                resourceNsxtPolicySecurityPolicy(),
+			"nsxt_policy_security_policy_rule":                           resourceNsxtPolicySecurityPolicyRule(),
+			"nsxt_policy_service":                                        resourceNsxtPolicyService(),
+			"nsxt_policy_gateway_policy":                                 resourceNsxtPolicyGatewayPolicy(),
+			"nsxt_policy_predefined_gateway_policy":                      resourceNsxtPolicyPredefinedGatewayPolicy(),
+			"nsxt_policy_predefined_security_policy":                     resourceNsxtPolicyPredefinedSecurityPolicy(),
+			"nsxt_policy_segment":                                        resourceNsxtPolicySegment(),
+			"nsxt_policy_vlan_segment":                                   resourceNsxtPolicyVlanSegment(),
+			"nsxt_policy_fixed_segment":                                  resourceNsxtPolicyFixedSegment(),
+			"nsxt_policy_static_route":                                   resourceNsxtPolicyStaticRoute(),
+			"nsxt_policy_gateway_prefix_list":                            resourceNsxtPolicyGatewayPrefixList(),
+			"nsxt_policy_vm_tags":                                        resourceNsxtPolicyVMTags(),
+			"nsxt_policy_nat_rule":                                       resourceNsxtPolicyNATRule(),
+			"nsxt_policy_ip_block":                                       resourceNsxtPolicyIPBlock(),
+			"nsxt_policy_lb_pool":                                        resourceNsxtPolicyLBPool(),
+			"nsxt_policy_ip_pool":                                        resourceNsxtPolicyIPPool(),
+			"nsxt_policy_ip_pool_block_subnet":                           resourceNsxtPolicyIPPoolBlockSubnet(),
+			"nsxt_policy_ip_pool_static_subnet":                          resourceNsxtPolicyIPPoolStaticSubnet(),
+			"nsxt_policy_lb_service":                                     resourceNsxtPolicyLBService(),
+			"nsxt_policy_lb_virtual_server":                              resourceNsxtPolicyLBVirtualServer(),
+			"nsxt_policy_ip_address_allocation":                          resourceNsxtPolicyIPAddressAllocation(),
+			"nsxt_policy_bgp_neighbor":                                   resourceNsxtPolicyBgpNeighbor(),
+			"nsxt_policy_bgp_config":                                     resourceNsxtPolicyBgpConfig(),
+			"nsxt_policy_dhcp_relay":                                     resourceNsxtPolicyDhcpRelayConfig(),
+			"nsxt_policy_dhcp_server":                                    resourceNsxtPolicyDhcpServer(),
+			"nsxt_policy_context_profile":                                resourceNsxtPolicyContextProfile(),
+			"nsxt_policy_dhcp_v4_static_binding":                         resourceNsxtPolicyDhcpV4StaticBinding(),
+			"nsxt_policy_dhcp_v6_static_binding":                         resourceNsxtPolicyDhcpV6StaticBinding(),
+			"nsxt_policy_dns_forwarder_zone":                             resourceNsxtPolicyDNSForwarderZone(),
+			"nsxt_policy_gateway_dns_forwarder":                          resourceNsxtPolicyGatewayDNSForwarder(),
+			"nsxt_policy_gateway_community_list":                         resourceNsxtPolicyGatewayCommunityList(),
+			"nsxt_policy_gateway_route_map":                              resourceNsxtPolicyGatewayRouteMap(),
+			"nsxt_policy_intrusion_service_policy":                       resourceNsxtPolicyIntrusionServicePolicy(),
+			"nsxt_policy_static_route_bfd_peer":                          resourceNsxtPolicyStaticRouteBfdPeer(),
+			"nsxt_policy_intrusion_service_profile":                      resourceNsxtPolicyIntrusionServiceProfile(),
+			"nsxt_policy_evpn_tenant":                                    resourceNsxtPolicyEvpnTenant(),
+			"nsxt_policy_evpn_config":                                    resourceNsxtPolicyEvpnConfig(),
+			"nsxt_policy_evpn_tunnel_endpoint":                           resourceNsxtPolicyEvpnTunnelEndpoint(),
+			"nsxt_policy_qos_profile":                                    resourceNsxtPolicyQosProfile(),
+			"nsxt_policy_ospf_config":                                    resourceNsxtPolicyOspfConfig(),
+			"nsxt_policy_ospf_area":                                      resourceNsxtPolicyOspfArea(),
+			"nsxt_policy_gateway_redistribution_config":                  resourceNsxtPolicyGatewayRedistributionConfig(),
+			"nsxt_policy_mac_discovery_profile":                          resourceNsxtPolicyMacDiscoveryProfile(),
+			"nsxt_policy_ipsec_vpn_ike_profile":                          resourceNsxtPolicyIPSecVpnIkeProfile(),
+			"nsxt_policy_ipsec_vpn_tunnel_profile":                       resourceNsxtPolicyIPSecVpnTunnelProfile(),
+			"nsxt_policy_ipsec_vpn_dpd_profile":                          resourceNsxtPolicyIPSecVpnDpdProfile(),
+			"nsxt_policy_firewall_exclude_list_member":                   resourceNsxtPolicyFirewallExcludeListMember(),
+			"nsxt_policy_firewall_session_timer_profile":                 resourceNsxtPolicyFirewallSessionTimerProfile(),
+			"nsxt_policy_firewall_session_timer_profile_group_binding":   resourceNsxtPolicyFirewallSessionTimerProfileGroupBinding(),
+			"nsxt_policy_firewall_session_timer_profile_gateway_binding": resourceNsxtPolicyFirewallSessionTimerProfileGatewayBinding(),
+			"nsxt_policy_distributed_flood_protection_profile":           resourceNsxtPolicyDistributedFloodProtectionProfile(),
+			"nsxt_policy_gateway_flood_protection_profile":               resourceNsxtPolicyGatewayFloodProtectionProfile(),
+			"nsxt_policy_flood_protection_profile_group_binding":         resourceNsxtPolicyFloodProtectionProfileGroupBinding(),
+			"nsxt_policy_flood_protection_profile_gateway_binding":       resourceNsxtPolicyFloodProtectionProfileGatewayBinding(),
+			"nsxt_policy_firewall_schedule":                              resourceNsxtPolicyFirewallSchedule(),
+			"nsxt_policy_firewall_identity_store":                        resourceNsxtPolicyFirewallIdentityStore(),
+			"nsxt_policy_firewall_identity_store_ldap_server":            resourceNsxtPolicyFirewallIdentityStoreLdapServer(),
+			"nsxt_policy_l7_access_profile":                              resourceNsxtPolicyL7AccessProfile(),
+			"nsxt_policy_tls_inspection_action_profile":                  resourceNsxtPolicyTLSInspectionActionProfile(),
+			"nsxt_policy_tls_inspection_policy":                          resourceNsxtPolicyTLSInspectionPolicy(),
+			"nsxt_policy_tls_inspection_config_profile_binding":          resourceNsxtPolicyTLSInspectionConfigProfileBinding(),
+			"nsxt_policy_service_chain":                                  resourceNsxtPolicyServiceChain(),
+			"nsxt_policy_redirection_policy":                             resourceNsxtPolicyRedirectionPolicy(),
+			"nsxt_policy_intrusion_service_cluster_config":               resourceNsxtPolicyIntrusionServiceClusterConfig(),
+			"nsxt_policy_intrusion_service_settings":                     resourceNsxtPolicyIntrusionServiceSettings(),
+			"nsxt_policy_intrusion_service_global_signature":             resourceNsxtPolicyIntrusionServiceGlobalSignature(),
+			"nsxt_policy_intrusion_service_gateway_policy":               resourceNsxtPolicyIntrusionServiceGatewayPolicy(),
+			"nsxt_policy_endpoint_protection_policy":                     resourceNsxtPolicyEndpointProtectionPolicy(),
+			"nsxt_policy_forwarding_policy":                              resourceNsxtPolicyForwardingPolicy(),
+			"nsxt_policy_dns_security_profile":                           resourceNsxtPolicyDNSSecurityProfile(),
+			"nsxt_policy_dns_security_profile_group_binding":             resourceNsxtPolicyDNSSecurityProfileGroupBinding(),
+		})),
 
 		ConfigureFunc: providerConfigure,
 	}
 }
 
 func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
-	clientAuthCertFile := d.Get("client_auth_cert_file").(string)
-	clientAuthKeyFile := d.Get("client_auth_key_file").(string)
-	clientAuthCert := d.Get("client_auth_cert").(string)
-	clientAuthKey := d.Get("client_auth_key").(string)
+	clientAuthCertFile := getProviderSetting(d, clients, "client_auth_cert_file")
+	clientAuthKeyFile := getProviderSetting(d, clients, "client_auth_key_file")
+	clientAuthCert := getProviderSetting(d, clients, "client_auth_cert")
+	clientAuthKey := getProviderSetting(d, clients, "client_auth_key")
+	bearerToken := getCredentialsProcessToken(clients)
 	vmcToken := d.Get("vmc_token").(string)
 	vmcAuthMode := d.Get("vmc_auth_mode").(string)
 
@@ -387,7 +490,7 @@ func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
 		return nil
 	}
 
-	needCreds := true
+	needCreds := len(bearerToken) == 0
 	if len(clientAuthCertFile) > 0 {
 		if len(clientAuthKeyFile) == 0 {
 			return fmt.Errorf("Please provide key file for client certificate")
@@ -404,8 +507,8 @@ func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
 	}
 
 	insecure := d.Get("allow_unverified_ssl").(bool)
-	username := d.Get("username").(string)
-	password := d.Get("password").(string)
+	username := getProviderSetting(d, clients, "username")
+	password := getProviderSetting(d, clients, "password")
 
 	if needCreds {
 		if username == "" {
@@ -417,7 +520,7 @@ func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
 		}
 	}
 
-	host := d.Get("host").(string)
+	host := getProviderSetting(d, clients, "host")
 	// Remove schema
 	host = strings.TrimPrefix(host, "https://")
 
@@ -425,8 +528,8 @@ func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
 		return fmt.Errorf("host must be provided")
 	}
 
-	caFile := d.Get("ca_file").(string)
-	caString := d.Get("ca").(string)
+	caFile := getProviderSetting(d, clients, "ca_file")
+	caString := getProviderSetting(d, clients, "ca")
 
 	retriesConfig := api.ClientRetriesConfiguration{
 		MaxRetries:      clients.CommonConfig.MaxRetries,
@@ -453,6 +556,20 @@ func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
 		RetriesConfiguration: retriesConfig,
 	}
 
+	if len(bearerToken) > 0 {
+		cfg.DefaultHeader = map[string]string{"Authorization": fmt.Sprintf("Bearer %s", bearerToken)}
+		cfg.SkipSessionAuth = true
+	}
+
+	if clients.CredentialsProcess != nil {
+		// credentials are applied per request, so that they are refreshed upon expiry
+		cfg.SkipSessionAuth = true
+		if err := api.InitHttpClient(&cfg); err != nil {
+			return err
+		}
+		cfg.HTTPClient.Transport = newCredentialsProcessTransport(clients.CredentialsProcess, cfg.RemoteAuth, cfg.HTTPClient.Transport)
+	}
+
 	nsxClient, err := api.NewAPIClient(&cfg)
 	if err != nil {
 		return err
@@ -500,15 +617,15 @@ func getAPIToken(vmcAuthHost string, vmcAccessToken string) (string, error) {
 	return token.AccessToken, nil
 }
 
-func getConnectorTLSConfig(d *schema.ResourceData) (*tls.Config, error) {
+func getConnectorTLSConfig(d *schema.ResourceData, clients *nsxtClients) (*tls.Config, error) {
 
 	insecure := d.Get("allow_unverified_ssl").(bool)
-	clientAuthCertFile := d.Get("client_auth_cert_file").(string)
-	clientAuthKeyFile := d.Get("client_auth_key_file").(string)
-	caFile := d.Get("ca_file").(string)
-	clientAuthCert := d.Get("client_auth_cert").(string)
-	clientAuthKey := d.Get("client_auth_key").(string)
-	caCert := d.Get("ca").(string)
+	clientAuthCertFile := getProviderSetting(d, clients, "client_auth_cert_file")
+	clientAuthKeyFile := getProviderSetting(d, clients, "client_auth_key_file")
+	caFile := getProviderSetting(d, clients, "ca_file")
+	clientAuthCert := getProviderSetting(d, clients, "client_auth_cert")
+	clientAuthKey := getProviderSetting(d, clients, "client_auth_key")
+	caCert := getProviderSetting(d, clients, "ca")
 	tlsConfig := tls.Config{InsecureSkipVerify: insecure}
 
 	if len(clientAuthCertFile) > 0 {
@@ -544,6 +661,12 @@ func getConnectorTLSConfig(d *schema.ResourceData) (*tls.Config, error) {
 		tlsConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
 			return &cert, nil
 		}
+
+		if clients.CredentialsProcess != nil && clients.CredentialsProcess.hasClientCertificate() {
+			// certificate is obtained from credentials process on each handshake,
+			// so that it is refreshed upon expiry
+			tlsConfig.GetClientCertificate = clients.CredentialsProcess.getClientCertificate
+		}
 	}
 
 	if len(caFile) > 0 {
@@ -569,13 +692,14 @@ func getConnectorTLSConfig(d *schema.ResourceData) (*tls.Config, error) {
 }
 
 func configurePolicyConnectorData(d *schema.ResourceData, clients *nsxtClients) error {
-	host := d.Get("host").(string)
-	username := d.Get("username").(string)
-	password := d.Get("password").(string)
+	host := getProviderSetting(d, clients, "host")
+	username := getProviderSetting(d, clients, "username")
+	password := getProviderSetting(d, clients, "password")
 	vmcAccessToken := d.Get("vmc_token").(string)
 	vmcAuthHost := d.Get("vmc_auth_host").(string)
-	clientAuthCertFile := d.Get("client_auth_cert_file").(string)
-	clientAuthCert := d.Get("client_auth_cert").(string)
+	clientAuthCertFile := getProviderSetting(d, clients, "client_auth_cert_file")
+	clientAuthCert := getProviderSetting(d, clients, "client_auth_cert")
+	bearerToken := getCredentialsProcessToken(clients)
 	clientAuthDefined := (len(clientAuthCertFile) > 0) || (len(clientAuthCert) > 0)
 	policyEnforcementPoint := d.Get("enforcement_point").(string)
 	policyGlobalManager := d.Get("global_manager").(bool)
@@ -595,6 +719,12 @@ func configurePolicyConnectorData(d *schema.ResourceData, clients *nsxtClients)
 		securityContextNeeded = false
 	}
 
+	if len(bearerToken) > 0 && len(vmcAccessToken) == 0 {
+		// token is refreshed on expiry when policy connector is allocated
+		clients.CommonConfig.BearerToken = bearerToken
+		securityContextNeeded = false
+	}
+
 	if securityContextNeeded {
 		if len(vmcAccessToken) > 0 {
 			if vmcAuthHost == "" {
@@ -628,7 +758,7 @@ func configurePolicyConnectorData(d *schema.ResourceData, clients *nsxtClients)
 		}
 	}
 
-	tlsConfig, err := getConnectorTLSConfig(d)
+	tlsConfig, err := getConnectorTLSConfig(d, clients)
 	if err != nil {
 		return err
 	}
@@ -701,10 +831,11 @@ func applyLicense(c *api.APIClient, licenseKey string) error {
 
 // license keys are applied on terraform plan and are not removed
 func configureLicenses(d *schema.ResourceData, clients *nsxtClients) error {
-	for _, licKey := range d.Get("license_keys").([]interface{}) {
+	for i, licKey := range d.Get("license_keys").([]interface{}) {
 		err := applyLicense(clients.NsxtClient, licKey.(string))
 		if err != nil {
-			return fmt.Errorf("Error applying license key: %s. %s", licKey, err.Error())
+			// license key is sensitive and should not appear in the output
+			return fmt.Errorf("Error applying license key #%d: %s", i, err.Error())
 		}
 	}
 	return nil
@@ -716,6 +847,10 @@ func initCommonConfig(d *schema.ResourceData) commonProviderConfig {
 	maxRetries := d.Get("max_retries").(int)
 	retryMinDelay := d.Get("retry_min_delay").(int)
 	retryMaxDelay := d.Get("retry_max_delay").(int)
+	checkRealization := d.Get("check_realization").(bool)
+	onConflict := d.Get("on_conflict").(string)
+	ruleAnalysis := d.Get("firewall_rule_analysis").(string)
+	approvedCategories := interface2StringList(d.Get("firewall_rule_analysis_approved_categories").([]interface{}))
 
 	statuses := d.Get("retry_on_status_codes").([]interface{})
 	retryStatuses := make([]int, 0, len(statuses))
@@ -735,16 +870,27 @@ func initCommonConfig(d *schema.ResourceData) commonProviderConfig {
 		MinRetryInterval:       retryMinDelay,
 		MaxRetryInterval:       retryMaxDelay,
 		RetryStatusCodes:       retryStatuses,
+		CheckRealization:       checkRealization,
+		OnConflict:             onConflict,
+
+		FirewallRuleAnalysis:                   ruleAnalysis,
+		FirewallRuleAnalysisApprovedCategories: approvedCategories,
 	}
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	commonConfig := initCommonConfig(d)
 	clients := nsxtClients{
-		CommonConfig: commonConfig,
+		CommonConfig:        commonConfig,
+		PolicyGroupIPRanges: newPolicyGroupIPRangesCache(),
 	}
 
-	err := configureNsxtClient(d, &clients)
+	err := configureCredentialSources(d, &clients)
+	if err != nil {
+		return nil, err
+	}
+
+	err = configureNsxtClient(d, &clients)
 	if err != nil {
 		return nil, err
 	}
@@ -795,15 +941,29 @@ func getPolicyConnector(clients interface{}) *client.RestConnector {
 		return true
 	}
 
+	securityContext := c.PolicySecurityContext
+	bearerToken := c.CommonConfig.BearerToken
+	var credentialsErr error
+	if c.CredentialsProcess != nil {
+		// re-invokes credentials process if credentials have expired
+		securityContext, bearerToken, credentialsErr = c.CredentialsProcess.getPolicySecurityData(securityContext, bearerToken)
+	}
+
 	connector := client.NewRestConnector(c.Host, *c.PolicyHTTPClient, client.WithDecorators(retry.NewRetryDecorator(uint(c.CommonConfig.MaxRetries), retryFunc)))
-	if c.PolicySecurityContext != nil {
-		connector.SetSecurityContext(c.PolicySecurityContext)
+	if securityContext != nil {
+		connector.SetSecurityContext(securityContext)
+	}
+	if credentialsErr != nil {
+		connector.AddRequestProcessor(credentialsErrorProcessor{err: credentialsErr})
 	}
 	if c.CommonConfig.RemoteAuth {
 		connector.AddRequestProcessor(newRemoteAuthHeaderProcessor())
 	}
-	if len(c.CommonConfig.BearerToken) > 0 {
-		connector.AddRequestProcessor(newBearerAuthHeaderProcessor(c.CommonConfig.BearerToken))
+	if len(bearerToken) > 0 {
+		connector.AddRequestProcessor(newBearerAuthHeaderProcessor(bearerToken))
+	}
+	if c.PolicyRevisionGuard != nil {
+		connector.AddRequestProcessor(newPolicyRevisionProcessor(c.PolicyRevisionGuard))
 	}
 
 	return connector
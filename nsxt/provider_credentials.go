@@ -0,0 +1,404 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/core"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/security"
+)
+
+const defaultProviderConfigFile = "~/.nsxt/config.json"
+
+// Credentials are refreshed this long before they expire
+const credentialsExpiryWindow = 1 * time.Minute
+
+// Provider settings that can be specified in a profile
+var providerProfileKeys = []string{
+	"host",
+	"username",
+	"password",
+	"client_auth_cert_file",
+	"client_auth_key_file",
+	"client_auth_cert",
+	"client_auth_key",
+	"ca_file",
+	"ca",
+	"credentials_process",
+}
+
+// Provider settings that can be returned by credentials process
+var credentialsProcessKeys = []string{
+	"username",
+	"password",
+	"client_auth_cert",
+	"client_auth_key",
+}
+
+type providerProfile map[string]string
+
+type processCredentials struct {
+	Username       string     `json:"username"`
+	Password       string     `json:"password"`
+	Token          string     `json:"token"`
+	ClientAuthCert string     `json:"client_auth_cert"`
+	ClientAuthKey  string     `json:"client_auth_key"`
+	Expiration     *time.Time `json:"expiration,omitempty"`
+}
+
+type credentialsProcess struct {
+	command     string
+	lock        sync.Mutex
+	credentials *processCredentials
+}
+
+func expandHomeDir(path string) (string, error) {
+	if !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, path[2:]), nil
+}
+
+func loadProviderProfile(configFile string, name string) (providerProfile, error) {
+	path, err := expandHomeDir(configFile)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read provider config file %s: %v", path, err)
+	}
+
+	var profiles map[string]providerProfile
+	if err := json.Unmarshal(data, &profiles); err != nil {
+		return nil, fmt.Errorf("Failed to parse provider config file %s: %v", path, err)
+	}
+
+	profile, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("Profile %s not found in provider config file %s", name, path)
+	}
+
+	for key := range profile {
+		if !stringInList(key, providerProfileKeys) {
+			return nil, fmt.Errorf("Unsupported setting %s in profile %s, supported settings are %s", key, name, strings.Join(providerProfileKeys, ", "))
+		}
+	}
+
+	return profile, nil
+}
+
+func newCredentialsProcess(command string) *credentialsProcess {
+	return &credentialsProcess{command: command}
+}
+
+func (creds *processCredentials) isExpired() bool {
+	if creds.Expiration == nil {
+		return false
+	}
+
+	return time.Now().Add(credentialsExpiryWindow).After(*creds.Expiration)
+}
+
+func (creds *processCredentials) get(key string) string {
+	switch key {
+	case "username":
+		return creds.Username
+	case "password":
+		return creds.Password
+	case "client_auth_cert":
+		return creds.ClientAuthCert
+	case "client_auth_key":
+		return creds.ClientAuthKey
+	}
+
+	return ""
+}
+
+func (p *credentialsProcess) run() (*processCredentials, error) {
+	args, err := splitCommandLine(p.command)
+	if err != nil {
+		return nil, err
+	}
+	if len(args) == 0 {
+		return nil, fmt.Errorf("credentials_process command is empty")
+	}
+
+	log.Printf("[DEBUG] Running credentials process %s", args[0])
+	cmd := exec.Command(args[0], args[1:]...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("Credentials process %s failed: %v: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	creds := processCredentials{}
+	if err := json.Unmarshal(stdout.Bytes(), &creds); err != nil {
+		return nil, fmt.Errorf("Failed to parse output of credentials process %s: %v", args[0], err)
+	}
+
+	hasBasic := len(creds.Username) > 0 && len(creds.Password) > 0
+	hasToken := len(creds.Token) > 0
+	hasCert := len(creds.ClientAuthCert) > 0 && len(creds.ClientAuthKey) > 0
+	if !hasBasic && !hasToken && !hasCert {
+		return nil, fmt.Errorf("Credentials process %s should return username and password, token, or client_auth_cert and client_auth_key", args[0])
+	}
+
+	return &creds, nil
+}
+
+// Get current credentials, invoking the process if credentials are not known yet
+// or about to expire
+func (p *credentialsProcess) get() (*processCredentials, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.credentials != nil && !p.credentials.isExpired() {
+		return p.credentials, nil
+	}
+
+	creds, err := p.run()
+	if err != nil {
+		if p.credentials != nil {
+			return nil, fmt.Errorf("Failed to refresh expired credentials: %v", err)
+		}
+		return nil, err
+	}
+
+	p.credentials = creds
+	return creds, nil
+}
+
+func (p *credentialsProcess) getClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	creds, err := p.get()
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := tls.X509KeyPair([]byte(creds.ClientAuthCert), []byte(creds.ClientAuthKey))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to load client cert/key pair: %v", err)
+	}
+
+	return &cert, nil
+}
+
+// Whether credentials process returns client certificate
+func (p *credentialsProcess) hasClientCertificate() bool {
+	creds, err := p.get()
+	if err != nil {
+		return false
+	}
+
+	return len(creds.ClientAuthCert) > 0
+}
+
+// Get policy connector security context and bearer token refreshed with current
+// credentials, for whichever authentication method is in use
+func (p *credentialsProcess) getPolicySecurityData(securityCtx *core.SecurityContextImpl, bearerToken string) (*core.SecurityContextImpl, string, error) {
+	creds, err := p.get()
+	if err != nil {
+		return securityCtx, bearerToken, err
+	}
+
+	if len(creds.Token) > 0 && len(bearerToken) > 0 {
+		return securityCtx, creds.Token, nil
+	}
+
+	if len(creds.Username) > 0 && securityCtx != nil && securityCtx.Property(security.AUTHENTICATION_SCHEME_ID) == security.USER_PASSWORD_SCHEME_ID {
+		newCtx := core.NewSecurityContextImpl()
+		newCtx.SetProperty(security.AUTHENTICATION_SCHEME_ID, security.USER_PASSWORD_SCHEME_ID)
+		newCtx.SetProperty(security.USER_KEY, creds.Username)
+		newCtx.SetProperty(security.PASSWORD_KEY, creds.Password)
+		return newCtx, bearerToken, nil
+	}
+
+	return securityCtx, bearerToken, nil
+}
+
+// Fails policy requests when credentials could not be obtained
+type credentialsErrorProcessor struct {
+	err error
+}
+
+func (processor credentialsErrorProcessor) Process(req *http.Request) error {
+	return processor.err
+}
+
+// Transport for MP client, that applies current credentials to each request.
+// MP client session is not used with credentials process, hence each request
+// is authenticated with credentials as of the time it is sent.
+type credentialsProcessTransport struct {
+	process    *credentialsProcess
+	remoteAuth bool
+	base       http.RoundTripper
+}
+
+func newCredentialsProcessTransport(process *credentialsProcess, remoteAuth bool, base http.RoundTripper) *credentialsProcessTransport {
+	if transport, ok := base.(*http.Transport); ok && transport.TLSClientConfig != nil && process.hasClientCertificate() {
+		// certificate is obtained from credentials process on each handshake
+		transport.TLSClientConfig.GetClientCertificate = process.getClientCertificate
+	}
+
+	return &credentialsProcessTransport{process: process, remoteAuth: remoteAuth, base: base}
+}
+
+func (t *credentialsProcessTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	creds, err := t.process.get()
+	if err != nil {
+		return nil, err
+	}
+
+	newReq := req.Clone(req.Context())
+	authHeader := req.Header.Get("Authorization")
+	_, _, hasBasic := req.BasicAuth()
+	if len(creds.Token) > 0 && strings.HasPrefix(authHeader, "Bearer ") {
+		newReq.Header.Set("Authorization", fmt.Sprintf("Bearer %s", creds.Token))
+	} else if len(creds.Username) > 0 && t.remoteAuth {
+		auth := base64.StdEncoding.EncodeToString([]byte(creds.Username + ":" + creds.Password))
+		newReq.Header.Set("Authorization", "Remote "+auth)
+	} else if len(creds.Username) > 0 && hasBasic {
+		newReq.SetBasicAuth(creds.Username, creds.Password)
+	}
+
+	return t.base.RoundTrip(newReq)
+}
+
+// Split command line into arguments, following shell quoting rules: arguments
+// are separated by whitespace, single quotes preserve enclosed characters, and
+// backslash escapes the next character outside single quotes
+func splitCommandLine(command string) ([]string, error) {
+	var args []string
+	var current strings.Builder
+	var quote rune
+	inArg := false
+	escaped := false
+
+	for _, c := range command {
+		switch {
+		case escaped:
+			current.WriteRune(c)
+			escaped = false
+		case quote == '\'':
+			if c == '\'' {
+				quote = 0
+			} else {
+				current.WriteRune(c)
+			}
+		case c == '\\':
+			escaped = true
+			inArg = true
+		case quote == '"':
+			if c == '"' {
+				quote = 0
+			} else {
+				current.WriteRune(c)
+			}
+		case c == '\'' || c == '"':
+			quote = c
+			inArg = true
+		case unicode.IsSpace(c):
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(c)
+			inArg = true
+		}
+	}
+
+	if escaped || quote != 0 {
+		return nil, fmt.Errorf("Unterminated quote or escape in credentials_process command %s", command)
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+
+	return args, nil
+}
+
+// Load profile and run credentials process, if configured
+func configureCredentialSources(d *schema.ResourceData, clients *nsxtClients) error {
+	profileName := d.Get("profile").(string)
+	if len(profileName) > 0 {
+		profile, err := loadProviderProfile(d.Get("config_file").(string), profileName)
+		if err != nil {
+			return err
+		}
+		clients.ProviderProfile = profile
+	}
+
+	command := d.Get("credentials_process").(string)
+	if command == "" {
+		command = clients.ProviderProfile["credentials_process"]
+	}
+
+	if len(command) > 0 {
+		process := newCredentialsProcess(command)
+		if _, err := process.get(); err != nil {
+			return err
+		}
+		clients.CredentialsProcess = process
+	}
+
+	return nil
+}
+
+// Get string provider setting. Credentials returned by credentials process take
+// precedence, followed by provider configuration and then by profile
+func getProviderSetting(d *schema.ResourceData, clients *nsxtClients, key string) string {
+	if clients.CredentialsProcess != nil && stringInList(key, credentialsProcessKeys) {
+		creds, err := clients.CredentialsProcess.get()
+		if err == nil && len(creds.get(key)) > 0 {
+			return creds.get(key)
+		}
+	}
+
+	value := d.Get(key).(string)
+	if len(value) > 0 {
+		return value
+	}
+
+	return clients.ProviderProfile[key]
+}
+
+// Get bearer token returned by credentials process, if any
+func getCredentialsProcessToken(clients *nsxtClients) string {
+	if clients.CredentialsProcess == nil {
+		return ""
+	}
+
+	creds, err := clients.CredentialsProcess.get()
+	if err != nil {
+		return ""
+	}
+
+	return creds.Token
+}
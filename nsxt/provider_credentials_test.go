@@ -0,0 +1,202 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testCredentialsHelperEnv = "NSXT_TEST_CREDENTIALS_HELPER"
+
+// Fake credentials process, invoked by tests below via test binary. Each run
+// increments the counter kept in the state file, and returns user<counter>.
+// The process fails when state file holds "fail".
+func TestCredentialsProcessHelper(t *testing.T) {
+	stateFile := os.Getenv(testCredentialsHelperEnv)
+	if stateFile == "" {
+		return
+	}
+
+	data, _ := ioutil.ReadFile(stateFile)
+	state := strings.TrimSpace(string(data))
+	if state == "fail" {
+		fmt.Fprintf(os.Stderr, "helper failure")
+		os.Exit(1)
+	}
+	counter, _ := strconv.Atoi(state)
+	counter++
+	ioutil.WriteFile(stateFile, []byte(strconv.Itoa(counter)), 0600)
+
+	creds := processCredentials{
+		Username: fmt.Sprintf("user%d", counter),
+		Password: "secret",
+	}
+	if lifetime := os.Getenv(testCredentialsHelperEnv + "_LIFETIME"); lifetime != "" {
+		duration, _ := time.ParseDuration(lifetime)
+		expiration := time.Now().Add(duration)
+		creds.Expiration = &expiration
+	}
+	if err := json.NewEncoder(os.Stdout).Encode(creds); err != nil {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+func testCredentialsHelperProcess(t *testing.T, lifetime string) (*credentialsProcess, string, func()) {
+	dir, err := ioutil.TempDir("", "nsxt creds")
+	if err != nil {
+		t.Fatal(err)
+	}
+	stateFile := filepath.Join(dir, "state")
+	os.Setenv(testCredentialsHelperEnv, stateFile)
+	os.Setenv(testCredentialsHelperEnv+"_LIFETIME", lifetime)
+
+	// Temp dir contains a space, to verify quoted arguments
+	command := fmt.Sprintf("'%s' -test.run=TestCredentialsProcessHelper", os.Args[0])
+	cleanup := func() {
+		os.Unsetenv(testCredentialsHelperEnv)
+		os.Unsetenv(testCredentialsHelperEnv + "_LIFETIME")
+		os.RemoveAll(dir)
+	}
+	return newCredentialsProcess(command), stateFile, cleanup
+}
+
+func TestSplitCommandLine(t *testing.T) {
+	cases := map[string][]string{
+		"/usr/bin/creds --env ci":               {"/usr/bin/creds", "--env", "ci"},
+		"  /usr/bin/creds   ":                   {"/usr/bin/creds"},
+		`"/opt/my tools/creds" --name 'a b'`:    {"/opt/my tools/creds", "--name", "a b"},
+		`/opt/my\ tools/creds --arg="x y"`:      {"/opt/my tools/creds", "--arg=x y"},
+		`creds --quote "it's" 'say "hi"' ""`:    {"creds", "--quote", "it's", `say "hi"`, ""},
+		`creds --escaped "a \"b\"" 'no\escape'`: {"creds", "--escaped", `a "b"`, `no\escape`},
+		"":                                      nil,
+	}
+
+	for command, expected := range cases {
+		args, err := splitCommandLine(command)
+		if err != nil {
+			t.Errorf("Unexpected error for %s: %v", command, err)
+			continue
+		}
+		if !reflect.DeepEqual(args, expected) {
+			t.Errorf("Command %s: expected %q, got %q", command, expected, args)
+		}
+	}
+
+	for _, command := range []string{`creds 'unterminated`, `creds "unterminated`, `creds trailing\`} {
+		if _, err := splitCommandLine(command); err == nil {
+			t.Errorf("Expected error for %s", command)
+		}
+	}
+}
+
+func TestCredentialsProcessGet(t *testing.T) {
+	process, _, cleanup := testCredentialsHelperProcess(t, "")
+	defer cleanup()
+
+	creds, err := process.get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if creds.Username != "user1" || creds.Password != "secret" {
+		t.Errorf("Unexpected credentials %s/%s", creds.Username, creds.Password)
+	}
+
+	// Credentials without expiration are not refreshed
+	creds, err = process.get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if creds.Username != "user1" {
+		t.Errorf("Expected cached credentials, got %s", creds.Username)
+	}
+}
+
+func TestCredentialsProcessRefresh(t *testing.T) {
+	// Lifetime within expiry window, hence credentials are refreshed on each get
+	process, stateFile, cleanup := testCredentialsHelperProcess(t, "30s")
+	defer cleanup()
+
+	creds, err := process.get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !creds.isExpired() {
+		t.Errorf("Expected credentials to be treated as expired")
+	}
+
+	creds, err = process.get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if creds.Username != "user2" {
+		t.Errorf("Expected refreshed credentials, got %s", creds.Username)
+	}
+
+	// Failed refresh should not keep expired credentials
+	if err := ioutil.WriteFile(stateFile, []byte("fail"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := process.get(); err == nil || !strings.Contains(err.Error(), "helper failure") {
+		t.Errorf("Expected refresh error, got %v", err)
+	}
+}
+
+func TestCredentialsProcessNotExpired(t *testing.T) {
+	process, _, cleanup := testCredentialsHelperProcess(t, "1h")
+	defer cleanup()
+
+	if _, err := process.get(); err != nil {
+		t.Fatal(err)
+	}
+	creds, err := process.get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if creds.Username != "user1" {
+		t.Errorf("Expected cached credentials, got %s", creds.Username)
+	}
+}
+
+type testRoundTripper struct {
+	req *http.Request
+}
+
+func (t *testRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.req = req
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
+
+func TestCredentialsProcessTransport(t *testing.T) {
+	process, _, cleanup := testCredentialsHelperProcess(t, "30s")
+	defer cleanup()
+
+	base := &testRoundTripper{}
+	transport := newCredentialsProcessTransport(process, false, base)
+	for i := 1; i <= 2; i++ {
+		req, _ := http.NewRequest(http.MethodGet, "https://nsx/api/v1/logical-ports", nil)
+		req.SetBasicAuth("static", "static")
+		if _, err := transport.RoundTrip(req); err != nil {
+			t.Fatal(err)
+		}
+		username, _, _ := base.req.BasicAuth()
+		expected := fmt.Sprintf("user%d", i)
+		if username != expected {
+			t.Errorf("Expected request with %s, got %s", expected, username)
+		}
+		if staticUsername, _, _ := req.BasicAuth(); staticUsername != "static" {
+			t.Errorf("Original request should not be modified")
+		}
+	}
+}
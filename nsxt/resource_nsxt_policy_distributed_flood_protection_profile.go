@@ -0,0 +1,34 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNsxtPolicyDistributedFloodProtectionProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyDistributedFloodProtectionProfileCreate,
+		Read:   resourceNsxtPolicyDistributedFloodProtectionProfileRead,
+		Update: resourceNsxtPolicyDistributedFloodProtectionProfileUpdate,
+		Delete: resourceNsxtPolicyFloodProtectionProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: getFloodProtectionProfileSchema(false),
+	}
+}
+
+func resourceNsxtPolicyDistributedFloodProtectionProfileCreate(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyFloodProtectionProfileCreate(d, m, false)
+}
+
+func resourceNsxtPolicyDistributedFloodProtectionProfileRead(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyFloodProtectionProfileRead(d, m, false)
+}
+
+func resourceNsxtPolicyDistributedFloodProtectionProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyFloodProtectionProfileUpdate(d, m, false)
+}
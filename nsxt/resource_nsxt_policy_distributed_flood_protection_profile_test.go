@@ -0,0 +1,138 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyDistributedFloodProtectionProfileCreateAttributes = map[string]string{
+	"display_name":             getAccTestResourceName(),
+	"description":              "terraform created",
+	"tcp_half_open_conn_limit": "1000",
+	"udp_active_flow_limit":    "2000",
+	"enable_syncache":          "true",
+}
+
+var accTestPolicyDistributedFloodProtectionProfileUpdateAttributes = map[string]string{
+	"display_name":             getAccTestResourceName(),
+	"description":              "terraform updated",
+	"tcp_half_open_conn_limit": "3000",
+	"udp_active_flow_limit":    "4000",
+	"enable_syncache":          "false",
+}
+
+func TestAccResourceNsxtPolicyDistributedFloodProtectionProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_distributed_flood_protection_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, accTestPolicyDistributedFloodProtectionProfileUpdateAttributes["display_name"], "nsxt_policy_distributed_flood_protection_profile", resourceNsxtPolicyFloodProtectionProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyDistributedFloodProtectionProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyFloodProtectionProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyDistributedFloodProtectionProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyDistributedFloodProtectionProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "tcp_half_open_conn_limit", accTestPolicyDistributedFloodProtectionProfileCreateAttributes["tcp_half_open_conn_limit"]),
+					resource.TestCheckResourceAttr(testResourceName, "udp_active_flow_limit", accTestPolicyDistributedFloodProtectionProfileCreateAttributes["udp_active_flow_limit"]),
+					resource.TestCheckResourceAttr(testResourceName, "enable_syncache", accTestPolicyDistributedFloodProtectionProfileCreateAttributes["enable_syncache"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyDistributedFloodProtectionProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyFloodProtectionProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyDistributedFloodProtectionProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyDistributedFloodProtectionProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "tcp_half_open_conn_limit", accTestPolicyDistributedFloodProtectionProfileUpdateAttributes["tcp_half_open_conn_limit"]),
+					resource.TestCheckResourceAttr(testResourceName, "udp_active_flow_limit", accTestPolicyDistributedFloodProtectionProfileUpdateAttributes["udp_active_flow_limit"]),
+					resource.TestCheckResourceAttr(testResourceName, "enable_syncache", accTestPolicyDistributedFloodProtectionProfileUpdateAttributes["enable_syncache"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyDistributedFloodProtectionProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyFloodProtectionProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "tcp_half_open_conn_limit", "0"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyDistributedFloodProtectionProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_distributed_flood_protection_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_distributed_flood_protection_profile", resourceNsxtPolicyFloodProtectionProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyDistributedFloodProtectionProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyDistributedFloodProtectionProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyDistributedFloodProtectionProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyDistributedFloodProtectionProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_distributed_flood_protection_profile" "test" {
+  display_name             = "%s"
+  description              = "%s"
+  tcp_half_open_conn_limit = %s
+  udp_active_flow_limit    = %s
+  enable_syncache          = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["tcp_half_open_conn_limit"], attrMap["udp_active_flow_limit"], attrMap["enable_syncache"])
+}
+
+func testAccNsxtPolicyDistributedFloodProtectionProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_distributed_flood_protection_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyDistributedFloodProtectionProfileUpdateAttributes["display_name"])
+}
@@ -0,0 +1,218 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyDNSSecurityProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyDNSSecurityProfileCreate,
+		Read:   resourceNsxtPolicyDNSSecurityProfileRead,
+		Update: resourceNsxtPolicyDNSSecurityProfileUpdate,
+		Delete: resourceNsxtPolicyDNSSecurityProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"ttl": {
+				Type:         schema.TypeInt,
+				Description:  "Time to live for DNS cache entry in seconds. 0 means cached entry never expires. If not set, TTL is taken from DNS response",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.Any(validation.IntInSlice([]int{0}), validation.IntBetween(3600, 864000)),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyDNSSecurityProfileExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	var err error
+	if isGlobalManager {
+		client := gm_infra.NewDnsSecurityProfilesClient(connector)
+		_, err = client.Get(id)
+	} else {
+		client := infra.NewDnsSecurityProfilesClient(connector)
+		_, err = client.Get(id)
+	}
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getPolicyDNSSecurityProfileFromSchema(d *schema.ResourceData) model.DnsSecurityProfile {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+
+	obj := model.DnsSecurityProfile{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+	}
+
+	// TTL of 0 is meaningful (never expire), while absent TTL means AUTO
+	if value, ok := d.GetOkExists("ttl"); ok {
+		ttl := int64(value.(int))
+		obj.Ttl = &ttl
+	}
+
+	return obj
+}
+
+func resourceNsxtPolicyDNSSecurityProfileCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyDNSSecurityProfileExists)
+	if err != nil {
+		return err
+	}
+
+	obj := getPolicyDNSSecurityProfileFromSchema(d)
+
+	// Create the resource using PATCH
+	log.Printf("[INFO] Creating DNS Security Profile with ID %s", id)
+	boolFalse := false
+	if isPolicyGlobalManager(m) {
+		gmObj, convErr := convertModelBindingType(obj, model.DnsSecurityProfileBindingType(), gm_model.DnsSecurityProfileBindingType())
+		if convErr != nil {
+			return convErr
+		}
+		client := gm_infra.NewDnsSecurityProfilesClient(connector)
+		err = client.Patch(id, gmObj.(gm_model.DnsSecurityProfile), &boolFalse)
+	} else {
+		client := infra.NewDnsSecurityProfilesClient(connector)
+		err = client.Patch(id, obj, &boolFalse)
+	}
+	if err != nil {
+		return handleCreateError("DNS Security Profile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyDNSSecurityProfileRead(d, m)
+}
+
+func resourceNsxtPolicyDNSSecurityProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining DNS Security Profile ID")
+	}
+
+	var obj model.DnsSecurityProfile
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDnsSecurityProfilesClient(connector)
+		gmObj, err := client.Get(id)
+		if err != nil {
+			return handleReadError(d, "DNS Security Profile", id, err)
+		}
+
+		lmObj, err := convertModelBindingType(gmObj, gm_model.DnsSecurityProfileBindingType(), model.DnsSecurityProfileBindingType())
+		if err != nil {
+			return err
+		}
+		obj = lmObj.(model.DnsSecurityProfile)
+	} else {
+		client := infra.NewDnsSecurityProfilesClient(connector)
+		var err error
+		obj, err = client.Get(id)
+		if err != nil {
+			return handleReadError(d, "DNS Security Profile", id, err)
+		}
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("ttl", obj.Ttl)
+
+	return nil
+}
+
+func resourceNsxtPolicyDNSSecurityProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining DNS Security Profile ID")
+	}
+
+	obj := getPolicyDNSSecurityProfileFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+
+	// Update the resource using PUT
+	var err error
+	boolFalse := false
+	if isPolicyGlobalManager(m) {
+		gmObj, convErr := convertModelBindingType(obj, model.DnsSecurityProfileBindingType(), gm_model.DnsSecurityProfileBindingType())
+		if convErr != nil {
+			return convErr
+		}
+		client := gm_infra.NewDnsSecurityProfilesClient(connector)
+		_, err = client.Update(id, gmObj.(gm_model.DnsSecurityProfile), &boolFalse)
+	} else {
+		client := infra.NewDnsSecurityProfilesClient(connector)
+		_, err = client.Update(id, obj, &boolFalse)
+	}
+	if err != nil {
+		return handleUpdateError("DNS Security Profile", id, err)
+	}
+
+	return resourceNsxtPolicyDNSSecurityProfileRead(d, m)
+}
+
+func resourceNsxtPolicyDNSSecurityProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining DNS Security Profile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	var err error
+	boolFalse := false
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewDnsSecurityProfilesClient(connector)
+		err = client.Delete(id, &boolFalse)
+	} else {
+		client := infra.NewDnsSecurityProfilesClient(connector)
+		err = client.Delete(id, &boolFalse)
+	}
+
+	if err != nil {
+		return handleDeleteError("DNS Security Profile", id, err)
+	}
+
+	return nil
+}
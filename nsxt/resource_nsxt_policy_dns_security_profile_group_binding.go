@@ -0,0 +1,111 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_groups "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/domains/groups"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains/groups"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var policyDNSSecurityProfileGroupBinding = policyProfileBindingResource{
+	name:               "DNS Security Profile Binding",
+	parentAttribute:    "group_path",
+	parentDescription:  "Policy path of the group to apply the profile to",
+	profileDescription: "Policy path of DNS security profile",
+	sequenceNumberSchema: &schema.Schema{
+		Type:         schema.TypeInt,
+		Description:  "Sequence number used to resolve conflicts when multiple profiles apply to a single group. Lower value gets higher precedence",
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntBetween(1, 100000),
+	},
+	importSeparator:     "/dns-security-profile-binding-maps/",
+	importParentExample: "/infra/domains/default/groups/<group-id>",
+	validateParent:      validatePolicyGroupPath,
+	get:                 getPolicyDNSSecurityProfileGroupBinding,
+	patch:               patchPolicyDNSSecurityProfileGroupBinding,
+	delete:              deletePolicyDNSSecurityProfileGroupBinding,
+}
+
+func resourceNsxtPolicyDNSSecurityProfileGroupBinding() *schema.Resource {
+	return policyDNSSecurityProfileGroupBinding.resource()
+}
+
+func getPolicyDNSSecurityProfileGroupBinding(connector *client.RestConnector, groupPath string, id string, isGlobalManager bool) (policyProfileBinding, error) {
+	domain, groupID, err := parsePolicyGroupPath(groupPath)
+	if err != nil {
+		return policyProfileBinding{}, err
+	}
+
+	var obj model.DnsSecurityProfileBindingMap
+	if isGlobalManager {
+		gmObj, err := gm_groups.NewDnsSecurityProfileBindingMapsClient(connector).Get(domain, groupID, id)
+		if err != nil {
+			return policyProfileBinding{}, err
+		}
+		rawObj, convErr := convertModelBindingType(gmObj, gm_model.DnsSecurityProfileBindingMapBindingType(), model.DnsSecurityProfileBindingMapBindingType())
+		if convErr != nil {
+			return policyProfileBinding{}, convErr
+		}
+		obj = rawObj.(model.DnsSecurityProfileBindingMap)
+	} else {
+		obj, err = groups.NewDnsSecurityProfileBindingMapsClient(connector).Get(domain, groupID, id)
+		if err != nil {
+			return policyProfileBinding{}, err
+		}
+	}
+
+	return policyProfileBinding{
+		DisplayName:    obj.DisplayName,
+		Description:    obj.Description,
+		Tags:           obj.Tags,
+		Path:           obj.Path,
+		Revision:       obj.Revision,
+		ProfilePath:    obj.ProfilePath,
+		SequenceNumber: obj.SequenceNumber,
+	}, nil
+}
+
+func patchPolicyDNSSecurityProfileGroupBinding(connector *client.RestConnector, groupPath string, id string, binding policyProfileBinding, isGlobalManager bool) error {
+	domain, groupID, err := parsePolicyGroupPath(groupPath)
+	if err != nil {
+		return err
+	}
+
+	obj := model.DnsSecurityProfileBindingMap{
+		DisplayName:    binding.DisplayName,
+		Description:    binding.Description,
+		Tags:           binding.Tags,
+		Revision:       binding.Revision,
+		ProfilePath:    binding.ProfilePath,
+		SequenceNumber: binding.SequenceNumber,
+	}
+
+	if isGlobalManager {
+		gmObj, convErr := convertModelBindingType(obj, model.DnsSecurityProfileBindingMapBindingType(), gm_model.DnsSecurityProfileBindingMapBindingType())
+		if convErr != nil {
+			return convErr
+		}
+		return gm_groups.NewDnsSecurityProfileBindingMapsClient(connector).Patch(domain, groupID, id, gmObj.(gm_model.DnsSecurityProfileBindingMap))
+	}
+
+	return groups.NewDnsSecurityProfileBindingMapsClient(connector).Patch(domain, groupID, id, obj)
+}
+
+func deletePolicyDNSSecurityProfileGroupBinding(connector *client.RestConnector, groupPath string, id string, isGlobalManager bool) error {
+	domain, groupID, err := parsePolicyGroupPath(groupPath)
+	if err != nil {
+		return err
+	}
+
+	if isGlobalManager {
+		return gm_groups.NewDnsSecurityProfileBindingMapsClient(connector).Delete(domain, groupID, id)
+	}
+	return groups.NewDnsSecurityProfileBindingMapsClient(connector).Delete(domain, groupID, id)
+}
@@ -0,0 +1,96 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyDNSSecurityProfileGroupBinding_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_dns_security_profile_group_binding.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyProfileBindingCheckDestroy(state, "nsxt_policy_dns_security_profile_group_binding", policyDNSSecurityProfileGroupBinding)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyDNSSecurityProfileGroupBindingTemplate(name, "profile1", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyProfileBindingExists(testResourceName, policyDNSSecurityProfileGroupBinding),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "sequence_number", "10"),
+					resource.TestCheckResourceAttrPair(testResourceName, "profile_path", "nsxt_policy_dns_security_profile.profile1", "path"),
+					resource.TestCheckResourceAttrPair(testResourceName, "group_path", "nsxt_policy_group.test", "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyDNSSecurityProfileGroupBindingTemplate(name, "profile2", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyProfileBindingExists(testResourceName, policyDNSSecurityProfileGroupBinding),
+					resource.TestCheckResourceAttr(testResourceName, "sequence_number", "20"),
+					resource.TestCheckResourceAttrPair(testResourceName, "profile_path", "nsxt_policy_dns_security_profile.profile2", "path"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyDNSSecurityProfileGroupBinding_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_dns_security_profile_group_binding.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyProfileBindingCheckDestroy(state, "nsxt_policy_dns_security_profile_group_binding", policyDNSSecurityProfileGroupBinding)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyDNSSecurityProfileGroupBindingTemplate(name, "profile1", 10),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyPathImporterGetID(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyDNSSecurityProfileGroupBindingTemplate(name string, profile string, sequenceNumber int) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_dns_security_profile" "profile1" {
+  display_name = "%s-1"
+  ttl          = 3600
+}
+
+resource "nsxt_policy_dns_security_profile" "profile2" {
+  display_name = "%s-2"
+  ttl          = 0
+}
+
+resource "nsxt_policy_dns_security_profile_group_binding" "test" {
+  display_name    = "%s"
+  group_path      = nsxt_policy_group.test.path
+  profile_path    = nsxt_policy_dns_security_profile.%s.path
+  sequence_number = %d
+}`, name, name, name, name, profile, sequenceNumber)
+}
@@ -0,0 +1,116 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyDNSSecurityProfileCreateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform created",
+	"ttl":          "3600",
+}
+
+var accTestPolicyDNSSecurityProfileUpdateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform updated",
+	"ttl":          "0",
+}
+
+func TestAccResourceNsxtPolicyDNSSecurityProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_dns_security_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, accTestPolicyDNSSecurityProfileUpdateAttributes["display_name"], "nsxt_policy_dns_security_profile", resourceNsxtPolicyDNSSecurityProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyDNSSecurityProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyDNSSecurityProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyDNSSecurityProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyDNSSecurityProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "ttl", accTestPolicyDNSSecurityProfileCreateAttributes["ttl"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyDNSSecurityProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyDNSSecurityProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyDNSSecurityProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyDNSSecurityProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "ttl", accTestPolicyDNSSecurityProfileUpdateAttributes["ttl"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyDNSSecurityProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_dns_security_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_dns_security_profile", resourceNsxtPolicyDNSSecurityProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyDNSSecurityProfileMinimalistic(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyDNSSecurityProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyDNSSecurityProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyDNSSecurityProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_dns_security_profile" "test" {
+  display_name = "%s"
+  description  = "%s"
+  ttl          = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["ttl"])
+}
+
+func testAccNsxtPolicyDNSSecurityProfileMinimalistic(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_dns_security_profile" "test" {
+  display_name = "%s"
+}`, name)
+}
@@ -0,0 +1,266 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyEndpointProtectionPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyEndpointProtectionPolicyCreate,
+		Read:   resourceNsxtPolicyEndpointProtectionPolicyRead,
+		Update: resourceNsxtPolicyEndpointProtectionPolicyUpdate,
+		Delete: resourceNsxtPolicyEndpointProtectionPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtDomainResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"domain":       getDomainNameSchema(),
+			"sequence_number": {
+				Type:        schema.TypeInt,
+				Description: "Sequence number of this policy relative to other endpoint protection policies",
+				Optional:    true,
+				Default:     0,
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Description: "List of endpoint protection rules",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"nsx_id":       getFlexNsxIDSchema(),
+						"display_name": getDisplayNameSchema(),
+						"description":  getDescriptionSchema(),
+						"revision":     getRevisionSchema(),
+						"tag":          getTagsSchema(),
+						"sequence_number": {
+							Type:        schema.TypeInt,
+							Description: "Sequence number of this rule",
+							Computed:    true,
+						},
+						"groups": {
+							Type:        schema.TypeSet,
+							Description: "Paths of groups protected by this rule",
+							Required:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validatePolicyPath(),
+							},
+						},
+						"service_profiles": {
+							Type:        schema.TypeSet,
+							Description: "Paths of partner service profiles applied to the groups",
+							Required:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validatePolicyPath(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyEndpointProtectionPolicyExistsInDomain(id string, domainName string, connector *client.RestConnector) (bool, error) {
+	client := domains.NewEndpointPoliciesClient(connector)
+	_, err := client.Get(domainName, id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Endpoint Protection Policy", err)
+}
+
+func resourceNsxtPolicyEndpointProtectionPolicyExistsPartial(domainName string) func(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	return func(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+		return resourceNsxtPolicyEndpointProtectionPolicyExistsInDomain(id, domainName, connector)
+	}
+}
+
+func getPolicyEndpointRulesFromSchema(d *schema.ResourceData) []model.EndpointRule {
+	var ruleList []model.EndpointRule
+	for seq, item := range d.Get("rule").([]interface{}) {
+		data := item.(map[string]interface{})
+		displayName := data["display_name"].(string)
+		description := data["description"].(string)
+		sequenceNumber := int64(seq)
+
+		id := newUUID()
+		if nsxID := data["nsx_id"].(string); nsxID != "" {
+			id = nsxID
+		}
+
+		ruleList = append(ruleList, model.EndpointRule{
+			Id:              &id,
+			DisplayName:     &displayName,
+			Description:     &description,
+			Tags:            getPolicyTagsFromSet(data["tag"].(*schema.Set)),
+			SequenceNumber:  &sequenceNumber,
+			Groups:          getPathListFromMap(data, "groups"),
+			ServiceProfiles: getPathListFromMap(data, "service_profiles"),
+		})
+	}
+
+	return ruleList
+}
+
+func setPolicyEndpointRulesInSchema(d *schema.ResourceData, rules []model.EndpointRule) error {
+	var rulesList []map[string]interface{}
+	for _, rule := range rules {
+		elem := make(map[string]interface{})
+		elem["nsx_id"] = rule.Id
+		elem["display_name"] = rule.DisplayName
+		elem["description"] = rule.Description
+		elem["revision"] = rule.Revision
+		elem["sequence_number"] = rule.SequenceNumber
+		setPathListInMap(elem, "groups", rule.Groups)
+		setPathListInMap(elem, "service_profiles", rule.ServiceProfiles)
+
+		var tagList []map[string]string
+		for _, tag := range rule.Tags {
+			tags := make(map[string]string)
+			tags["scope"] = *tag.Scope
+			tags["tag"] = *tag.Tag
+			tagList = append(tagList, tags)
+		}
+		elem["tag"] = tagList
+
+		rulesList = append(rulesList, elem)
+	}
+
+	return d.Set("rule", rulesList)
+}
+
+func getPolicyEndpointProtectionPolicyFromSchema(d *schema.ResourceData) model.EndpointPolicy {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	sequenceNumber := int64(d.Get("sequence_number").(int))
+
+	return model.EndpointPolicy{
+		DisplayName:    &displayName,
+		Description:    &description,
+		Tags:           tags,
+		SequenceNumber: &sequenceNumber,
+		EndpointRules:  getPolicyEndpointRulesFromSchema(d),
+	}
+}
+
+func resourceNsxtPolicyEndpointProtectionPolicyCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return resourceNotSupportedError()
+	}
+
+	connector := getPolicyConnector(m)
+	domain := d.Get("domain").(string)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyEndpointProtectionPolicyExistsPartial(domain))
+	if err != nil {
+		return err
+	}
+
+	obj := getPolicyEndpointProtectionPolicyFromSchema(d)
+
+	// Create the resource using PATCH
+	log.Printf("[INFO] Creating Endpoint Protection Policy with ID %s", id)
+	client := domains.NewEndpointPoliciesClient(connector)
+	err = client.Patch(domain, id, obj)
+	if err != nil {
+		return handleCreateError("Endpoint Protection Policy", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyEndpointProtectionPolicyRead(d, m)
+}
+
+func resourceNsxtPolicyEndpointProtectionPolicyRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	domain := d.Get("domain").(string)
+	if id == "" {
+		return fmt.Errorf("Error obtaining Endpoint Protection Policy ID")
+	}
+
+	client := domains.NewEndpointPoliciesClient(connector)
+	obj, err := client.Get(domain, id)
+	if err != nil {
+		return handleReadError(d, "Endpoint Protection Policy", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("domain", getDomainFromResourcePath(*obj.Path))
+	d.Set("sequence_number", obj.SequenceNumber)
+
+	return setPolicyEndpointRulesInSchema(d, obj.EndpointRules)
+}
+
+func resourceNsxtPolicyEndpointProtectionPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	domain := d.Get("domain").(string)
+	if id == "" {
+		return fmt.Errorf("Error obtaining Endpoint Protection Policy ID")
+	}
+
+	obj := getPolicyEndpointProtectionPolicyFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+
+	// Update the resource using PUT, so that rules removed from configuration
+	// are deleted on NSX
+	client := domains.NewEndpointPoliciesClient(connector)
+	_, err := client.Update(domain, id, obj)
+	if err != nil {
+		return handleUpdateError("Endpoint Protection Policy", id, err)
+	}
+
+	return resourceNsxtPolicyEndpointProtectionPolicyRead(d, m)
+}
+
+func resourceNsxtPolicyEndpointProtectionPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	domain := d.Get("domain").(string)
+	if id == "" {
+		return fmt.Errorf("Error obtaining Endpoint Protection Policy ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := domains.NewEndpointPoliciesClient(connector)
+	err := client.Delete(domain, id)
+	if err != nil {
+		return handleDeleteError("Endpoint Protection Policy", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccNsxtPolicyEndpointProtectionPolicyPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+	testAccOnlyLocalManager(t)
+	testAccEnvDefined(t, "NSXT_TEST_ENDPOINT_SERVICE_PROFILE_PATH")
+}
+
+func TestAccResourceNsxtPolicyEndpointProtectionPolicy_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_policy_endpoint_protection_policy.test"
+	existsFunc := resourceNsxtPolicyEndpointProtectionPolicyExistsPartial(defaultDomain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccNsxtPolicyEndpointProtectionPolicyPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, updatedName, "nsxt_policy_endpoint_protection_policy", existsFunc)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyEndpointProtectionPolicyTemplate(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, existsFunc),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "domain", defaultDomain),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", "rule1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.sequence_number", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.groups.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.service_profiles.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.display_name", "rule2"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rule.0.nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyEndpointProtectionPolicyTemplate(updatedName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, existsFunc),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", "rule1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyEndpointProtectionPolicy_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_endpoint_protection_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccNsxtPolicyEndpointProtectionPolicyPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_endpoint_protection_policy", resourceNsxtPolicyEndpointProtectionPolicyExistsPartial(defaultDomain))
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyEndpointProtectionPolicyTemplate(name, true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyEndpointProtectionPolicyTemplate(name string, withSecondRule bool) string {
+	secondRule := ""
+	if withSecondRule {
+		secondRule = fmt.Sprintf(`
+  rule {
+    display_name     = "rule2"
+    groups           = [nsxt_policy_group.test2.path]
+    service_profiles = ["%s"]
+  }`, getTestEndpointServiceProfilePath())
+	}
+
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test1" {
+  display_name = "%s-1"
+}
+
+resource "nsxt_policy_group" "test2" {
+  display_name = "%s-2"
+}
+
+resource "nsxt_policy_endpoint_protection_policy" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+
+  rule {
+    display_name     = "rule1"
+    groups           = [nsxt_policy_group.test1.path]
+    service_profiles = ["%s"]
+  }
+%s
+}`, name, name, name, getTestEndpointServiceProfilePath(), secondRule)
+}
@@ -0,0 +1,123 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall/security"
+)
+
+func resourceNsxtPolicyFirewallExcludeListMember() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyFirewallExcludeListMemberCreate,
+		Read:   resourceNsxtPolicyFirewallExcludeListMemberRead,
+		Delete: resourceNsxtPolicyFirewallExcludeListMemberDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyFirewallExcludeListMemberImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"member": getPolicyPathSchema(true, true, "Policy path of group, segment, segment port or virtual machine to exclude from distributed firewall"),
+		},
+	}
+}
+
+// Add or remove exclude list member with get/update, so that concurrent changes by
+// other members are not overridden
+func updatePolicyFirewallExcludeListMember(m interface{}, member string, add bool) error {
+	client := security.NewExcludeListClient(getPolicyConnector(m))
+	doUpdate := func() error {
+		obj, err := client.Get()
+		if err != nil {
+			return err
+		}
+
+		var members []string
+		for _, existing := range obj.Members {
+			if existing != member {
+				members = append(members, existing)
+			}
+		}
+		if add {
+			members = append(members, member)
+		} else if len(members) == len(obj.Members) {
+			log.Printf("[DEBUG] Member %s not found in firewall exclude list", member)
+			return nil
+		}
+
+		obj.Members = members
+		_, err = client.Update(obj)
+		return err
+	}
+
+	commonProviderConfig := getCommonProviderConfig(m)
+	return retryUponPreconditionFailed(doUpdate, commonProviderConfig.MaxRetries)
+}
+
+func resourceNsxtPolicyFirewallExcludeListMemberCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return resourceNotSupportedError()
+	}
+
+	member := d.Get("member").(string)
+	err := updatePolicyFirewallExcludeListMember(m, member, true)
+	if err != nil {
+		return handleCreateError("Firewall Exclude List Member", member, err)
+	}
+
+	d.SetId(member)
+
+	return resourceNsxtPolicyFirewallExcludeListMemberRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallExcludeListMemberRead(d *schema.ResourceData, m interface{}) error {
+	member := d.Id()
+	if member == "" {
+		return fmt.Errorf("Error obtaining Firewall Exclude List Member id")
+	}
+
+	client := security.NewExcludeListClient(getPolicyConnector(m))
+	obj, err := client.Get()
+	if err != nil {
+		return handleReadError(d, "Firewall Exclude List Member", member, err)
+	}
+
+	if !stringInList(member, obj.Members) {
+		log.Printf("[DEBUG] Member %s not found in firewall exclude list", member)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("member", member)
+
+	return nil
+}
+
+func resourceNsxtPolicyFirewallExcludeListMemberDelete(d *schema.ResourceData, m interface{}) error {
+	member := d.Id()
+	if member == "" {
+		return fmt.Errorf("Error obtaining Firewall Exclude List Member id")
+	}
+
+	err := updatePolicyFirewallExcludeListMember(m, member, false)
+	if err != nil {
+		return handleDeleteError("Firewall Exclude List Member", member, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyFirewallExcludeListMemberImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	member := d.Id()
+	if !isPolicyPath(member) {
+		return nil, fmt.Errorf("Please provide policy path of exclude list member as an input")
+	}
+
+	d.Set("member", member)
+
+	return []*schema.ResourceData{d}, nil
+}
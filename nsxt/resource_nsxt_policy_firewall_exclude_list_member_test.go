@@ -0,0 +1,125 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall/security"
+)
+
+func TestAccResourceNsxtPolicyFirewallExcludeListMember_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_firewall_exclude_list_member.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyFirewallExcludeListMemberCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallExcludeListMemberTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyFirewallExcludeListMemberExists(testResourceName),
+					resource.TestCheckResourceAttrPair(testResourceName, "member", "nsxt_policy_group.test", "path"),
+					resource.TestCheckResourceAttrSet("data.nsxt_policy_firewall_exclude_list.test", "path"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyFirewallExcludeListMember_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_firewall_exclude_list_member.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyFirewallExcludeListMemberCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallExcludeListMemberTemplate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyFirewallExcludeListMemberExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Firewall Exclude List Member resource %s not found in resources", resourceName)
+		}
+
+		member := rs.Primary.ID
+		if member == "" {
+			return fmt.Errorf("Policy Firewall Exclude List Member resource ID not set in resources")
+		}
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+		obj, err := security.NewExcludeListClient(connector).Get()
+		if err != nil {
+			return err
+		}
+
+		if !stringInList(member, obj.Members) {
+			return fmt.Errorf("Member %s not found in firewall exclude list", member)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyFirewallExcludeListMemberCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "nsxt_policy_firewall_exclude_list_member" {
+			continue
+		}
+
+		obj, err := security.NewExcludeListClient(connector).Get()
+		if err != nil {
+			return err
+		}
+
+		if stringInList(rs.Primary.ID, obj.Members) {
+			return fmt.Errorf("Member %s still present in firewall exclude list", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyFirewallExcludeListMemberTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_firewall_exclude_list_member" "test" {
+  member = nsxt_policy_group.test.path
+}
+
+data "nsxt_policy_firewall_exclude_list" "test" {
+  depends_on = [nsxt_policy_firewall_exclude_list_member.test]
+}`, name)
+}
@@ -0,0 +1,240 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyFirewallIdentityStore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyFirewallIdentityStoreCreate,
+		Read:   resourceNsxtPolicyFirewallIdentityStoreRead,
+		Update: resourceNsxtPolicyFirewallIdentityStoreUpdate,
+		Delete: resourceNsxtPolicyFirewallIdentityStoreDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"domain_name": {
+				Type:        schema.TypeString,
+				Description: "Fully qualified name of Active Directory domain",
+				Required:    true,
+			},
+			"base_distinguished_name": {
+				Type:        schema.TypeString,
+				Description: "Base distinguished name of the domain, for example DC=example,DC=com",
+				Required:    true,
+			},
+			"netbios_name": {
+				Type:        schema.TypeString,
+				Description: "NetBIOS name of the domain",
+				Optional:    true,
+				Computed:    true,
+			},
+			"delta_sync_interval": {
+				Type:         schema.TypeInt,
+				Description:  "Interval in minutes between delta synchronizations",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"full_sync_cron_expr": {
+				Type:        schema.TypeString,
+				Description: "Full synchronization schedule as cron expression",
+				Optional:    true,
+				Computed:    true,
+			},
+			"sync_delay": {
+				Type:         schema.TypeInt,
+				Description:  "Delay in seconds before initial full synchronization after the identity store is created. Set to -1 to skip initial synchronization",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+		},
+	}
+}
+
+func getPolicyFirewallIdentityStore(connector *client.RestConnector, id string) (model.DirectoryAdDomain, error) {
+	client := infra.NewFirewallIdentityStoresClient(connector)
+	dataValue, err := client.Get(id, nil)
+	if err != nil {
+		return model.DirectoryAdDomain{}, err
+	}
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+	obj, errs := converter.ConvertToGolang(dataValue, model.DirectoryAdDomainBindingType())
+	if len(errs) > 0 {
+		return model.DirectoryAdDomain{}, fmt.Errorf("Error converting Firewall Identity Store %s: %v", id, errs[0])
+	}
+
+	return obj.(model.DirectoryAdDomain), nil
+}
+
+func resourceNsxtPolicyFirewallIdentityStoreExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	client := infra.NewFirewallIdentityStoresClient(connector)
+	_, err := client.Get(id, nil)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func policyFirewallIdentityStorePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	domainName := d.Get("domain_name").(string)
+	baseDN := d.Get("base_distinguished_name").(string)
+
+	obj := model.DirectoryAdDomain{
+		DisplayName:           &displayName,
+		Description:           &description,
+		Tags:                  tags,
+		ResourceType:          model.DirectoryDomain_RESOURCE_TYPE_DIRECTORYADDOMAIN,
+		Name:                  &domainName,
+		BaseDistinguishedName: &baseDN,
+		SyncSettings:          &model.DirectoryDomainSyncSettings{},
+	}
+
+	// attributes that should only be set if they have a value specified
+	if netbiosName := d.Get("netbios_name").(string); netbiosName != "" {
+		obj.NetbiosName = &netbiosName
+	}
+	if value, ok := d.GetOk("delta_sync_interval"); ok {
+		interval := int64(value.(int))
+		obj.SyncSettings.DeltaSyncInterval = &interval
+	}
+	if cronExpr := d.Get("full_sync_cron_expr").(string); cronExpr != "" {
+		obj.SyncSettings.FullSyncCronExpr = &cronExpr
+	}
+	if value, ok := d.GetOk("sync_delay"); ok {
+		delay := int64(value.(int))
+		obj.SyncSettings.SyncDelayInSec = &delay
+	}
+
+	if len(d.Id()) > 0 {
+		// This is update flow
+		revision := int64(d.Get("revision").(int))
+		obj.Revision = &revision
+	}
+
+	dataValue, errs := converter.ConvertToVapi(obj, model.DirectoryAdDomainBindingType())
+	if errs != nil {
+		return fmt.Errorf("Error converting Firewall Identity Store: %v", errs[0])
+	}
+
+	log.Printf("[INFO] Patching Firewall Identity Store with ID %s", id)
+	client := infra.NewFirewallIdentityStoresClient(connector)
+	return client.Patch(id, dataValue.(*data.StructValue), nil)
+}
+
+func resourceNsxtPolicyFirewallIdentityStoreCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return resourceNotSupportedError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyFirewallIdentityStoreExists)
+	if err != nil {
+		return err
+	}
+
+	err = policyFirewallIdentityStorePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("Firewall Identity Store", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyFirewallIdentityStoreRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallIdentityStoreRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Firewall Identity Store ID")
+	}
+
+	obj, err := getPolicyFirewallIdentityStore(connector, id)
+	if err != nil {
+		return handleReadError(d, "Firewall Identity Store", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("revision", obj.Revision)
+
+	d.Set("domain_name", obj.Name)
+	d.Set("base_distinguished_name", obj.BaseDistinguishedName)
+	d.Set("netbios_name", obj.NetbiosName)
+	if obj.SyncSettings != nil {
+		d.Set("delta_sync_interval", obj.SyncSettings.DeltaSyncInterval)
+		d.Set("full_sync_cron_expr", obj.SyncSettings.FullSyncCronExpr)
+		d.Set("sync_delay", obj.SyncSettings.SyncDelayInSec)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyFirewallIdentityStoreUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Firewall Identity Store ID")
+	}
+
+	err := policyFirewallIdentityStorePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("Firewall Identity Store", id, err)
+	}
+
+	return resourceNsxtPolicyFirewallIdentityStoreRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallIdentityStoreDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Firewall Identity Store ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewFirewallIdentityStoresClient(connector)
+	err := client.Delete(id, nil)
+	if err != nil {
+		return handleDeleteError("Firewall Identity Store", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,240 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/firewall_identity_stores"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var firewallIdentityStoreLdapServerProtocolValues = []string{
+	model.DirectoryLdapServer_PROTOCOL_LDAP,
+	model.DirectoryLdapServer_PROTOCOL_LDAPS,
+}
+
+func resourceNsxtPolicyFirewallIdentityStoreLdapServer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyFirewallIdentityStoreLdapServerCreate,
+		Read:   resourceNsxtPolicyFirewallIdentityStoreLdapServerRead,
+		Update: resourceNsxtPolicyFirewallIdentityStoreLdapServerUpdate,
+		Delete: resourceNsxtPolicyFirewallIdentityStoreLdapServerDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyFirewallIdentityStoreLdapServerImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"identity_store_id": {
+				Type:        schema.TypeString,
+				Description: "ID of firewall identity store this LDAP server belongs to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Description: "Host name or IP address of LDAP server",
+				Required:    true,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Description:  "TCP port of LDAP server",
+				Optional:     true,
+				Default:      389,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Description:  "LDAP connection protocol",
+				Optional:     true,
+				Default:      model.DirectoryLdapServer_PROTOCOL_LDAP,
+				ValidateFunc: validation.StringInSlice(firewallIdentityStoreLdapServerProtocolValues, false),
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "User name used to bind to LDAP server",
+				Required:    true,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Description: "Password used to bind to LDAP server",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"thumbprint": {
+				Type:        schema.TypeString,
+				Description: "SHA-256 thumbprint of LDAP server certificate, required for LDAPS",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyFirewallIdentityStoreLdapServerExistsPartial(storeID string) func(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	return func(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+		client := firewall_identity_stores.NewLdapServersClient(connector)
+		_, err := client.Get(storeID, id, nil)
+		if err == nil {
+			return true, nil
+		}
+
+		if isNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, logAPIError("Error retrieving Firewall Identity Store LDAP Server", err)
+	}
+}
+
+func policyFirewallIdentityStoreLdapServerPatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+	storeID := d.Get("identity_store_id").(string)
+
+	// LDAP server carries the domain name of its identity store
+	store, err := getPolicyFirewallIdentityStore(connector, storeID)
+	if err != nil {
+		return err
+	}
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	host := d.Get("host").(string)
+	port := int64(d.Get("port").(int))
+	protocol := d.Get("protocol").(string)
+	username := d.Get("username").(string)
+	password := d.Get("password").(string)
+
+	obj := model.DirectoryLdapServer{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		DomainName:  store.Name,
+		Host:        &host,
+		Port:        &port,
+		Protocol:    &protocol,
+		Username:    &username,
+		Password:    &password,
+	}
+
+	if thumbprint := d.Get("thumbprint").(string); thumbprint != "" {
+		obj.Thumbprint = &thumbprint
+	}
+
+	if len(d.Id()) > 0 {
+		// This is update flow
+		revision := int64(d.Get("revision").(int))
+		obj.Revision = &revision
+	}
+
+	log.Printf("[INFO] Patching Firewall Identity Store LDAP Server with ID %s", id)
+	client := firewall_identity_stores.NewLdapServersClient(connector)
+	_, err = client.Patch(storeID, id, obj, nil)
+	return err
+}
+
+func resourceNsxtPolicyFirewallIdentityStoreLdapServerCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return resourceNotSupportedError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyFirewallIdentityStoreLdapServerExistsPartial(d.Get("identity_store_id").(string)))
+	if err != nil {
+		return err
+	}
+
+	err = policyFirewallIdentityStoreLdapServerPatch(d, m, id)
+	if err != nil {
+		return handleCreateError("Firewall Identity Store LDAP Server", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyFirewallIdentityStoreLdapServerRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallIdentityStoreLdapServerRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Firewall Identity Store LDAP Server ID")
+	}
+
+	client := firewall_identity_stores.NewLdapServersClient(connector)
+	obj, err := client.Get(d.Get("identity_store_id").(string), id, nil)
+	if err != nil {
+		return handleReadError(d, "Firewall Identity Store LDAP Server", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("revision", obj.Revision)
+
+	// NOTE: password is not returned on API responses
+	d.Set("host", obj.Host)
+	d.Set("port", obj.Port)
+	d.Set("protocol", obj.Protocol)
+	d.Set("username", obj.Username)
+	d.Set("thumbprint", obj.Thumbprint)
+
+	return nil
+}
+
+func resourceNsxtPolicyFirewallIdentityStoreLdapServerUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Firewall Identity Store LDAP Server ID")
+	}
+
+	err := policyFirewallIdentityStoreLdapServerPatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("Firewall Identity Store LDAP Server", id, err)
+	}
+
+	return resourceNsxtPolicyFirewallIdentityStoreLdapServerRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallIdentityStoreLdapServerDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Firewall Identity Store LDAP Server ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := firewall_identity_stores.NewLdapServersClient(connector)
+	err := client.Delete(d.Get("identity_store_id").(string), id, nil)
+	if err != nil {
+		return handleDeleteError("Firewall Identity Store LDAP Server", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyFirewallIdentityStoreLdapServerImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	s := strings.Split(importID, "/")
+	if len(s) != 2 {
+		return nil, fmt.Errorf("Please provide <identity-store-id>/<ldap-server-id> as an input")
+	}
+
+	d.Set("identity_store_id", s[0])
+	d.SetId(s[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,158 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyFirewallIdentityStoreLdapServer_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_firewall_identity_store_ldap_server.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_LDAP_SERVER")
+			testAccEnvDefined(t, "NSXT_TEST_LDAP_USERNAME")
+			testAccEnvDefined(t, "NSXT_TEST_LDAP_PASSWORD")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyFirewallIdentityStoreLdapServerCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallIdentityStoreLdapServerTemplate(name, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyFirewallIdentityStoreLdapServerExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(testResourceName, "host", getTestLdapServer()),
+					resource.TestCheckResourceAttr(testResourceName, "port", "389"),
+					resource.TestCheckResourceAttr(testResourceName, "protocol", "LDAP"),
+					resource.TestCheckResourceAttr(testResourceName, "username", getTestLdapUsername()),
+					resource.TestCheckResourceAttrPair(testResourceName, "identity_store_id", "nsxt_policy_firewall_identity_store.test", "id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyFirewallIdentityStoreLdapServerTemplate(name, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyFirewallIdentityStoreLdapServerExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyFirewallIdentityStoreLdapServer_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_firewall_identity_store_ldap_server.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_LDAP_SERVER")
+			testAccEnvDefined(t, "NSXT_TEST_LDAP_USERNAME")
+			testAccEnvDefined(t, "NSXT_TEST_LDAP_PASSWORD")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyFirewallIdentityStoreLdapServerCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallIdentityStoreLdapServerTemplate(name, "description1"),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccNsxtPolicyFirewallIdentityStoreLdapServerImporterGetID,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyFirewallIdentityStoreLdapServerImporterGetID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["nsxt_policy_firewall_identity_store_ldap_server.test"]
+	if !ok {
+		return "", fmt.Errorf("NSX Policy Firewall Identity Store LDAP Server resource not found in resources")
+	}
+	storeID := rs.Primary.Attributes["identity_store_id"]
+	if storeID == "" {
+		return "", fmt.Errorf("NSX Policy Firewall Identity Store LDAP Server identity_store_id not set in resources")
+	}
+	return fmt.Sprintf("%s/%s", storeID, rs.Primary.ID), nil
+}
+
+func testAccNsxtPolicyFirewallIdentityStoreLdapServerExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Firewall Identity Store LDAP Server resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Firewall Identity Store LDAP Server resource ID not set in resources")
+		}
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+		exists, err := resourceNsxtPolicyFirewallIdentityStoreLdapServerExistsPartial(rs.Primary.Attributes["identity_store_id"])(resourceID, connector, false)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Firewall Identity Store LDAP Server %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyFirewallIdentityStoreLdapServerCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "nsxt_policy_firewall_identity_store_ldap_server" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyFirewallIdentityStoreLdapServerExistsPartial(rs.Primary.Attributes["identity_store_id"])(resourceID, connector, false)
+		if err == nil && exists {
+			return fmt.Errorf("Policy Firewall Identity Store LDAP Server %s still exists", resourceID)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyFirewallIdentityStoreLdapServerTemplate(name string, description string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_firewall_identity_store" "test" {
+  display_name            = "%s"
+  domain_name             = "terraform.example.com"
+  base_distinguished_name = "DC=terraform,DC=example,DC=com"
+  sync_delay              = -1
+}
+
+resource "nsxt_policy_firewall_identity_store_ldap_server" "test" {
+  display_name      = "%s"
+  description       = "%s"
+  identity_store_id = nsxt_policy_firewall_identity_store.test.id
+  host              = "%s"
+  username          = "%s"
+  password          = "%s"
+}`, name, name, description, getTestLdapServer(), getTestLdapUsername(), getTestLdapPassword())
+}
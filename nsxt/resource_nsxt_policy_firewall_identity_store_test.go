@@ -0,0 +1,104 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyFirewallIdentityStore_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_firewall_identity_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyFirewallIdentityStoreCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallIdentityStoreTemplate(name, 180),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyFirewallIdentityStoreExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "domain_name", "terraform.example.com"),
+					resource.TestCheckResourceAttr(testResourceName, "base_distinguished_name", "DC=terraform,DC=example,DC=com"),
+					resource.TestCheckResourceAttr(testResourceName, "delta_sync_interval", "180"),
+					resource.TestCheckResourceAttr(testResourceName, "sync_delay", "-1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyFirewallIdentityStoreTemplate(name, 360),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyFirewallIdentityStoreExists),
+					resource.TestCheckResourceAttr(testResourceName, "delta_sync_interval", "360"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyFirewallIdentityStore_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_firewall_identity_store.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyFirewallIdentityStoreCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallIdentityStoreTemplate(name, 180),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyFirewallIdentityStoreCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "nsxt_policy_firewall_identity_store" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyFirewallIdentityStoreExists(resourceID, connector, false)
+		if err == nil && exists {
+			return fmt.Errorf("Policy Firewall Identity Store %s still exists", resourceID)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyFirewallIdentityStoreTemplate(name string, deltaSyncInterval int) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_firewall_identity_store" "test" {
+  display_name            = "%s"
+  domain_name             = "terraform.example.com"
+  base_distinguished_name = "DC=terraform,DC=example,DC=com"
+  netbios_name            = "TERRAFORM"
+  delta_sync_interval     = %d
+  sync_delay              = -1
+}`, name, deltaSyncInterval)
+}
@@ -0,0 +1,279 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var firewallScheduleDaysValues = []string{
+	model.PolicyFirewallScheduler_DAYS_SUNDAY,
+	model.PolicyFirewallScheduler_DAYS_MONDAY,
+	model.PolicyFirewallScheduler_DAYS_TUESDAY,
+	model.PolicyFirewallScheduler_DAYS_WEDNESDAY,
+	model.PolicyFirewallScheduler_DAYS_THURSDAY,
+	model.PolicyFirewallScheduler_DAYS_FRIDAY,
+	model.PolicyFirewallScheduler_DAYS_SATURDAY,
+}
+
+var firewallScheduleTimezoneValues = []string{
+	model.PolicyFirewallScheduler_TIMEZONE_UTC,
+	model.PolicyFirewallScheduler_TIMEZONE_LOCAL,
+}
+
+func resourceNsxtPolicyFirewallSchedule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyFirewallScheduleCreate,
+		Read:   resourceNsxtPolicyFirewallScheduleRead,
+		Update: resourceNsxtPolicyFirewallScheduleUpdate,
+		Delete: resourceNsxtPolicyFirewallScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"recurring": {
+				Type:        schema.TypeBool,
+				Description: "Whether the schedule recurs on given days and time intervals, or is a single time window",
+				Optional:    true,
+				Default:     true,
+			},
+			"days": {
+				Type:        schema.TypeSet,
+				Description: "Days of week on which recurring schedule is enforced",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(firewallScheduleDaysValues, false),
+				},
+			},
+			"time_interval": {
+				Type:        schema.TypeList,
+				Description: "Time intervals within a day during which recurring schedule is enforced",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_time": {
+							Type:        schema.TypeString,
+							Description: "Start time in 24 hour format, in multiple of 30 minutes, for example 9:00",
+							Required:    true,
+						},
+						"end_time": {
+							Type:        schema.TypeString,
+							Description: "End time in 24 hour format, in multiple of 30 minutes, for example 17:30",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"start_date": {
+				Type:        schema.TypeString,
+				Description: "Date on which schedule starts, for example 02/22/2019",
+				Required:    true,
+			},
+			"end_date": {
+				Type:        schema.TypeString,
+				Description: "Date on which schedule ends, for example 12/22/2019",
+				Optional:    true,
+			},
+			"start_time": {
+				Type:        schema.TypeString,
+				Description: "Time on start date from which non-recurring schedule is enforced, for example 9:00",
+				Optional:    true,
+			},
+			"end_time": {
+				Type:        schema.TypeString,
+				Description: "Time on end date until which non-recurring schedule is enforced, for example 17:30",
+				Optional:    true,
+			},
+			"timezone": {
+				Type:         schema.TypeString,
+				Description:  "Host timezone used to enforce the schedule",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(firewallScheduleTimezoneValues, false),
+				Default:      model.PolicyFirewallScheduler_TIMEZONE_UTC,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyFirewallScheduleExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	client := infra.NewFirewallSchedulersClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getPolicyFirewallScheduleFromSchema(d *schema.ResourceData) model.PolicyFirewallScheduler {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	recurring := d.Get("recurring").(bool)
+	startDate := d.Get("start_date").(string)
+	timezone := d.Get("timezone").(string)
+
+	obj := model.PolicyFirewallScheduler{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		Recurring:   &recurring,
+		StartDate:   &startDate,
+		Timezone:    &timezone,
+		Days:        getStringListFromSchemaSet(d, "days"),
+	}
+
+	// attributes that should only be set if they have a value specified
+	if endDate := d.Get("end_date").(string); endDate != "" {
+		obj.EndDate = &endDate
+	}
+	if startTime := d.Get("start_time").(string); startTime != "" {
+		obj.StartTime = &startTime
+	}
+	if endTime := d.Get("end_time").(string); endTime != "" {
+		obj.EndTime = &endTime
+	}
+
+	var intervals []model.PolicyTimeIntervalValue
+	for _, item := range d.Get("time_interval").([]interface{}) {
+		data := item.(map[string]interface{})
+		startInterval := data["start_time"].(string)
+		endInterval := data["end_time"].(string)
+		intervals = append(intervals, model.PolicyTimeIntervalValue{
+			StartInterval: &startInterval,
+			EndInterval:   &endInterval,
+		})
+	}
+	obj.TimeInterval = intervals
+
+	return obj
+}
+
+func resourceNsxtPolicyFirewallScheduleCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return resourceNotSupportedError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyFirewallScheduleExists)
+	if err != nil {
+		return err
+	}
+
+	obj := getPolicyFirewallScheduleFromSchema(d)
+
+	// Create the resource using PATCH
+	log.Printf("[INFO] Creating Firewall Schedule with ID %s", id)
+	client := infra.NewFirewallSchedulersClient(connector)
+	err = client.Patch(id, obj)
+	if err != nil {
+		return handleCreateError("Firewall Schedule", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyFirewallScheduleRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallScheduleRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Firewall Schedule ID")
+	}
+
+	client := infra.NewFirewallSchedulersClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "Firewall Schedule", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("recurring", obj.Recurring)
+	d.Set("days", obj.Days)
+	d.Set("start_date", obj.StartDate)
+	d.Set("end_date", obj.EndDate)
+	d.Set("start_time", obj.StartTime)
+	d.Set("end_time", obj.EndTime)
+	d.Set("timezone", obj.Timezone)
+
+	var intervals []map[string]interface{}
+	for _, interval := range obj.TimeInterval {
+		elem := make(map[string]interface{})
+		elem["start_time"] = interval.StartInterval
+		elem["end_time"] = interval.EndInterval
+		intervals = append(intervals, elem)
+	}
+	d.Set("time_interval", intervals)
+
+	return nil
+}
+
+func resourceNsxtPolicyFirewallScheduleUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Firewall Schedule ID")
+	}
+
+	obj := getPolicyFirewallScheduleFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+
+	// Update the resource using PUT, so that attributes removed from configuration
+	// are cleared on NSX
+	client := infra.NewFirewallSchedulersClient(connector)
+	_, err := client.Update(id, obj)
+	if err != nil {
+		return handleUpdateError("Firewall Schedule", id, err)
+	}
+
+	return resourceNsxtPolicyFirewallScheduleRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallScheduleDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Firewall Schedule ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewFirewallSchedulersClient(connector)
+	err := client.Delete(id, nil)
+	if err != nil {
+		return handleDeleteError("Firewall Schedule", id, err)
+	}
+
+	return nil
+}
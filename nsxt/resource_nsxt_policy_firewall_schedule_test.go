@@ -0,0 +1,169 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyFirewallSchedule_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_firewall_schedule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_firewall_schedule", resourceNsxtPolicyFirewallScheduleExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallScheduleRecurringTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyFirewallScheduleExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "recurring", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "days.#", "5"),
+					resource.TestCheckResourceAttr(testResourceName, "time_interval.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "time_interval.0.start_time", "9:00"),
+					resource.TestCheckResourceAttr(testResourceName, "time_interval.0.end_time", "17:30"),
+					resource.TestCheckResourceAttr(testResourceName, "start_date", "01/01/2022"),
+					resource.TestCheckResourceAttr(testResourceName, "timezone", "UTC"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyFirewallScheduleWindowTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyFirewallScheduleExists),
+					resource.TestCheckResourceAttr(testResourceName, "recurring", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "days.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "time_interval.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "start_date", "01/01/2022"),
+					resource.TestCheckResourceAttr(testResourceName, "end_date", "01/02/2022"),
+					resource.TestCheckResourceAttr(testResourceName, "start_time", "22:00"),
+					resource.TestCheckResourceAttr(testResourceName, "end_time", "2:00"),
+					resource.TestCheckResourceAttr(testResourceName, "timezone", "LOCAL"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyFirewallSchedule_withPolicy(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_security_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_firewall_schedule", resourceNsxtPolicyFirewallScheduleExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallScheduleWithPolicyTemplate(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(testResourceName, "schedule_path", "nsxt_policy_firewall_schedule.test", "path"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyFirewallScheduleWithPolicyTemplate(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "schedule_path", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyFirewallSchedule_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_firewall_schedule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_firewall_schedule", resourceNsxtPolicyFirewallScheduleExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallScheduleRecurringTemplate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyFirewallScheduleRecurringTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_firewall_schedule" "test" {
+  display_name = "%s"
+  days         = ["MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY"]
+  start_date   = "01/01/2022"
+
+  time_interval {
+    start_time = "9:00"
+    end_time   = "17:30"
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name)
+}
+
+func testAccNsxtPolicyFirewallScheduleWindowTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_firewall_schedule" "test" {
+  display_name = "%s"
+  recurring    = false
+  start_date   = "01/01/2022"
+  end_date     = "01/02/2022"
+  start_time   = "22:00"
+  end_time     = "2:00"
+  timezone     = "LOCAL"
+}`, name)
+}
+
+func testAccNsxtPolicyFirewallScheduleWithPolicyTemplate(name string, withSchedule bool) string {
+	schedule := ""
+	if withSchedule {
+		schedule = "schedule_path = nsxt_policy_firewall_schedule.test.path"
+	}
+	return testAccNsxtPolicyFirewallScheduleRecurringTemplate(name) + fmt.Sprintf(`
+resource "nsxt_policy_security_policy" "test" {
+  display_name = "%s"
+  category     = "Application"
+  %s
+
+  rule {
+    display_name = "business-hours"
+    action       = "ALLOW"
+  }
+}`, name, schedule)
+}
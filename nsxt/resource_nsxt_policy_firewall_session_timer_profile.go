@@ -0,0 +1,257 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func getFirewallSessionTimeoutSchema(description string, minValue int, defaultValue int) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Description:  description,
+		Optional:     true,
+		ValidateFunc: validation.IntBetween(minValue, 4320000),
+		Default:      defaultValue,
+	}
+}
+
+func resourceNsxtPolicyFirewallSessionTimerProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyFirewallSessionTimerProfileCreate,
+		Read:   resourceNsxtPolicyFirewallSessionTimerProfileRead,
+		Update: resourceNsxtPolicyFirewallSessionTimerProfileUpdate,
+		Delete: resourceNsxtPolicyFirewallSessionTimerProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":            getNsxIDSchema(),
+			"path":              getPathSchema(),
+			"display_name":      getDisplayNameSchema(),
+			"description":       getDescriptionSchema(),
+			"revision":          getRevisionSchema(),
+			"tag":               getTagsSchema(),
+			"tcp_first_packet":  getFirewallSessionTimeoutSchema("Timeout in seconds after the first TCP packet has been sent", 1, 120),
+			"tcp_opening":       getFirewallSessionTimeoutSchema("Timeout in seconds after a second TCP packet has been transferred", 1, 30),
+			"tcp_established":   getFirewallSessionTimeoutSchema("Timeout in seconds once TCP connection has become fully established", 120, 43200),
+			"tcp_closing":       getFirewallSessionTimeoutSchema("Timeout in seconds after the first FIN has been sent", 1, 120),
+			"tcp_finwait":       getFirewallSessionTimeoutSchema("Timeout in seconds after both FINs have been exchanged and connection is closed", 1, 45),
+			"tcp_closed":        getFirewallSessionTimeoutSchema("Timeout in seconds after one endpoint sends an RST", 1, 20),
+			"udp_first_packet":  getFirewallSessionTimeoutSchema("Timeout in seconds after the first UDP packet", 1, 60),
+			"udp_single":        getFirewallSessionTimeoutSchema("Timeout in seconds if the source host sends more than one UDP packet but the destination host has never sent one back", 1, 30),
+			"udp_multiple":      getFirewallSessionTimeoutSchema("Timeout in seconds if both hosts have sent UDP packets", 1, 60),
+			"icmp_first_packet": getFirewallSessionTimeoutSchema("Timeout in seconds after the first ICMP packet", 1, 20),
+			"icmp_error_reply":  getFirewallSessionTimeoutSchema("Timeout in seconds after an ICMP error came back in response to an ICMP packet", 1, 10),
+		},
+	}
+}
+
+func resourceNsxtPolicyFirewallSessionTimerProfileExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	var err error
+	if isGlobalManager {
+		client := gm_infra.NewFirewallSessionTimerProfilesClient(connector)
+		_, err = client.Get(id)
+	} else {
+		client := infra.NewFirewallSessionTimerProfilesClient(connector)
+		_, err = client.Get(id)
+	}
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getPolicyFirewallSessionTimerProfileFromSchema(d *schema.ResourceData) model.PolicyFirewallSessionTimerProfile {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	tcpFirstPacket := int64(d.Get("tcp_first_packet").(int))
+	tcpOpening := int64(d.Get("tcp_opening").(int))
+	tcpEstablished := int64(d.Get("tcp_established").(int))
+	tcpClosing := int64(d.Get("tcp_closing").(int))
+	tcpFinwait := int64(d.Get("tcp_finwait").(int))
+	tcpClosed := int64(d.Get("tcp_closed").(int))
+	udpFirstPacket := int64(d.Get("udp_first_packet").(int))
+	udpSingle := int64(d.Get("udp_single").(int))
+	udpMultiple := int64(d.Get("udp_multiple").(int))
+	icmpFirstPacket := int64(d.Get("icmp_first_packet").(int))
+	icmpErrorReply := int64(d.Get("icmp_error_reply").(int))
+
+	return model.PolicyFirewallSessionTimerProfile{
+		DisplayName:     &displayName,
+		Description:     &description,
+		Tags:            tags,
+		TcpFirstPacket:  &tcpFirstPacket,
+		TcpOpening:      &tcpOpening,
+		TcpEstablished:  &tcpEstablished,
+		TcpClosing:      &tcpClosing,
+		TcpFinwait:      &tcpFinwait,
+		TcpClosed:       &tcpClosed,
+		UdpFirstPacket:  &udpFirstPacket,
+		UdpSingle:       &udpSingle,
+		UdpMultiple:     &udpMultiple,
+		IcmpFirstPacket: &icmpFirstPacket,
+		IcmpErrorReply:  &icmpErrorReply,
+	}
+}
+
+func resourceNsxtPolicyFirewallSessionTimerProfileCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyFirewallSessionTimerProfileExists)
+	if err != nil {
+		return err
+	}
+
+	obj := getPolicyFirewallSessionTimerProfileFromSchema(d)
+
+	// Create the resource using PATCH
+	log.Printf("[INFO] Creating Firewall Session Timer Profile with ID %s", id)
+	boolFalse := false
+	if isPolicyGlobalManager(m) {
+		gmObj, convErr := convertModelBindingType(obj, model.PolicyFirewallSessionTimerProfileBindingType(), gm_model.PolicyFirewallSessionTimerProfileBindingType())
+		if convErr != nil {
+			return convErr
+		}
+		client := gm_infra.NewFirewallSessionTimerProfilesClient(connector)
+		err = client.Patch(id, gmObj.(gm_model.PolicyFirewallSessionTimerProfile), &boolFalse)
+	} else {
+		client := infra.NewFirewallSessionTimerProfilesClient(connector)
+		err = client.Patch(id, obj, &boolFalse)
+	}
+	if err != nil {
+		return handleCreateError("Firewall Session Timer Profile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyFirewallSessionTimerProfileRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallSessionTimerProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Firewall Session Timer Profile ID")
+	}
+
+	var obj model.PolicyFirewallSessionTimerProfile
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewFirewallSessionTimerProfilesClient(connector)
+		gmObj, err := client.Get(id)
+		if err != nil {
+			return handleReadError(d, "Firewall Session Timer Profile", id, err)
+		}
+
+		lmObj, err := convertModelBindingType(gmObj, gm_model.PolicyFirewallSessionTimerProfileBindingType(), model.PolicyFirewallSessionTimerProfileBindingType())
+		if err != nil {
+			return err
+		}
+		obj = lmObj.(model.PolicyFirewallSessionTimerProfile)
+	} else {
+		client := infra.NewFirewallSessionTimerProfilesClient(connector)
+		var err error
+		obj, err = client.Get(id)
+		if err != nil {
+			return handleReadError(d, "Firewall Session Timer Profile", id, err)
+		}
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("tcp_first_packet", obj.TcpFirstPacket)
+	d.Set("tcp_opening", obj.TcpOpening)
+	d.Set("tcp_established", obj.TcpEstablished)
+	d.Set("tcp_closing", obj.TcpClosing)
+	d.Set("tcp_finwait", obj.TcpFinwait)
+	d.Set("tcp_closed", obj.TcpClosed)
+	d.Set("udp_first_packet", obj.UdpFirstPacket)
+	d.Set("udp_single", obj.UdpSingle)
+	d.Set("udp_multiple", obj.UdpMultiple)
+	d.Set("icmp_first_packet", obj.IcmpFirstPacket)
+	d.Set("icmp_error_reply", obj.IcmpErrorReply)
+
+	return nil
+}
+
+func resourceNsxtPolicyFirewallSessionTimerProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Firewall Session Timer Profile ID")
+	}
+
+	obj := getPolicyFirewallSessionTimerProfileFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+
+	// Update the resource using PUT
+	var err error
+	boolFalse := false
+	if isPolicyGlobalManager(m) {
+		gmObj, convErr := convertModelBindingType(obj, model.PolicyFirewallSessionTimerProfileBindingType(), gm_model.PolicyFirewallSessionTimerProfileBindingType())
+		if convErr != nil {
+			return convErr
+		}
+		client := gm_infra.NewFirewallSessionTimerProfilesClient(connector)
+		_, err = client.Update(id, gmObj.(gm_model.PolicyFirewallSessionTimerProfile), &boolFalse)
+	} else {
+		client := infra.NewFirewallSessionTimerProfilesClient(connector)
+		_, err = client.Update(id, obj, &boolFalse)
+	}
+	if err != nil {
+		return handleUpdateError("Firewall Session Timer Profile", id, err)
+	}
+
+	return resourceNsxtPolicyFirewallSessionTimerProfileRead(d, m)
+}
+
+func resourceNsxtPolicyFirewallSessionTimerProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Firewall Session Timer Profile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	var err error
+	boolFalse := false
+	if isPolicyGlobalManager(m) {
+		client := gm_infra.NewFirewallSessionTimerProfilesClient(connector)
+		err = client.Delete(id, &boolFalse)
+	} else {
+		client := infra.NewFirewallSessionTimerProfilesClient(connector)
+		err = client.Delete(id, &boolFalse)
+	}
+
+	if err != nil {
+		return handleDeleteError("Firewall Session Timer Profile", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_tier0s "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/tier_0s"
+	gm_tier1s "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/tier_1s"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var policyFirewallSessionTimerProfileGatewayBinding = policyProfileBindingResource{
+	name:                "Firewall Session Timer Profile Gateway Binding",
+	parentAttribute:     "gateway_path",
+	parentDescription:   "Policy path of Tier-0 or Tier-1 gateway to apply the profile to",
+	profileDescription:  "Policy path of firewall session timer profile",
+	importSeparator:     "/session-timer-profile-bindings/",
+	importParentExample: "/infra/tier-1s/<gateway-id>",
+	validateParent:      validatePolicyGatewayPath,
+	get:                 getPolicyFirewallSessionTimerProfileGatewayBinding,
+	patch:               patchPolicyFirewallSessionTimerProfileGatewayBinding,
+	delete:              deletePolicyFirewallSessionTimerProfileGatewayBinding,
+}
+
+func resourceNsxtPolicyFirewallSessionTimerProfileGatewayBinding() *schema.Resource {
+	return policyFirewallSessionTimerProfileGatewayBinding.resource()
+}
+
+func getPolicyFirewallSessionTimerProfileGatewayBinding(connector *client.RestConnector, gwPath string, id string, isGlobalManager bool) (policyProfileBinding, error) {
+	isT0, gwID, _, err := parseGatewayOrLocaleServicePolicyPath(gwPath)
+	if err != nil {
+		return policyProfileBinding{}, err
+	}
+
+	var obj model.SessionTimerProfileBindingMap
+	if isGlobalManager {
+		var gmObj gm_model.SessionTimerProfileBindingMap
+		if isT0 {
+			gmObj, err = gm_tier0s.NewSessionTimerProfileBindingsClient(connector).Get(gwID, id)
+		} else {
+			gmObj, err = gm_tier1s.NewSessionTimerProfileBindingsClient(connector).Get(gwID, id)
+		}
+		if err != nil {
+			return policyProfileBinding{}, err
+		}
+		rawObj, convErr := convertModelBindingType(gmObj, gm_model.SessionTimerProfileBindingMapBindingType(), model.SessionTimerProfileBindingMapBindingType())
+		if convErr != nil {
+			return policyProfileBinding{}, convErr
+		}
+		obj = rawObj.(model.SessionTimerProfileBindingMap)
+	} else {
+		if isT0 {
+			obj, err = tier_0s.NewSessionTimerProfileBindingsClient(connector).Get(gwID, id)
+		} else {
+			obj, err = tier_1s.NewSessionTimerProfileBindingsClient(connector).Get(gwID, id)
+		}
+		if err != nil {
+			return policyProfileBinding{}, err
+		}
+	}
+
+	return policyProfileBinding{
+		DisplayName: obj.DisplayName,
+		Description: obj.Description,
+		Tags:        obj.Tags,
+		Path:        obj.Path,
+		Revision:    obj.Revision,
+		ProfilePath: obj.ProfilePath,
+	}, nil
+}
+
+func patchPolicyFirewallSessionTimerProfileGatewayBinding(connector *client.RestConnector, gwPath string, id string, binding policyProfileBinding, isGlobalManager bool) error {
+	isT0, gwID, _, err := parseGatewayOrLocaleServicePolicyPath(gwPath)
+	if err != nil {
+		return err
+	}
+
+	obj := model.SessionTimerProfileBindingMap{
+		DisplayName: binding.DisplayName,
+		Description: binding.Description,
+		Tags:        binding.Tags,
+		Revision:    binding.Revision,
+		ProfilePath: binding.ProfilePath,
+	}
+
+	if isGlobalManager {
+		gmObj, convErr := convertModelBindingType(obj, model.SessionTimerProfileBindingMapBindingType(), gm_model.SessionTimerProfileBindingMapBindingType())
+		if convErr != nil {
+			return convErr
+		}
+		if isT0 {
+			return gm_tier0s.NewSessionTimerProfileBindingsClient(connector).Patch(gwID, id, gmObj.(gm_model.SessionTimerProfileBindingMap))
+		}
+		return gm_tier1s.NewSessionTimerProfileBindingsClient(connector).Patch(gwID, id, gmObj.(gm_model.SessionTimerProfileBindingMap))
+	}
+
+	if isT0 {
+		return tier_0s.NewSessionTimerProfileBindingsClient(connector).Patch(gwID, id, obj)
+	}
+	return tier_1s.NewSessionTimerProfileBindingsClient(connector).Patch(gwID, id, obj)
+}
+
+func deletePolicyFirewallSessionTimerProfileGatewayBinding(connector *client.RestConnector, gwPath string, id string, isGlobalManager bool) error {
+	isT0, gwID, _, err := parseGatewayOrLocaleServicePolicyPath(gwPath)
+	if err != nil {
+		return err
+	}
+
+	if isGlobalManager {
+		if isT0 {
+			return gm_tier0s.NewSessionTimerProfileBindingsClient(connector).Delete(gwID, id)
+		}
+		return gm_tier1s.NewSessionTimerProfileBindingsClient(connector).Delete(gwID, id)
+	}
+
+	if isT0 {
+		return tier_0s.NewSessionTimerProfileBindingsClient(connector).Delete(gwID, id)
+	}
+	return tier_1s.NewSessionTimerProfileBindingsClient(connector).Delete(gwID, id)
+}
@@ -0,0 +1,90 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyFirewallSessionTimerProfileGatewayBinding_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_firewall_session_timer_profile_gateway_binding.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyProfileBindingCheckDestroy(state, "nsxt_policy_firewall_session_timer_profile_gateway_binding", policyFirewallSessionTimerProfileGatewayBinding)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallSessionTimerProfileGatewayBindingTemplate(name, "profile1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyProfileBindingExists(testResourceName, policyFirewallSessionTimerProfileGatewayBinding),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttrPair(testResourceName, "profile_path", "nsxt_policy_firewall_session_timer_profile.profile1", "path"),
+					resource.TestCheckResourceAttrPair(testResourceName, "gateway_path", "nsxt_policy_tier1_gateway.test", "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyFirewallSessionTimerProfileGatewayBindingTemplate(name, "profile2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyProfileBindingExists(testResourceName, policyFirewallSessionTimerProfileGatewayBinding),
+					resource.TestCheckResourceAttrPair(testResourceName, "profile_path", "nsxt_policy_firewall_session_timer_profile.profile2", "path"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyFirewallSessionTimerProfileGatewayBinding_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_firewall_session_timer_profile_gateway_binding.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyProfileBindingCheckDestroy(state, "nsxt_policy_firewall_session_timer_profile_gateway_binding", policyFirewallSessionTimerProfileGatewayBinding)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallSessionTimerProfileGatewayBindingTemplate(name, "profile1"),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyPathImporterGetID(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyFirewallSessionTimerProfileGatewayBindingTemplate(name string, profile string) string {
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) +
+		testAccNsxtPolicyTier1WithEdgeClusterTemplate("test", false) + fmt.Sprintf(`
+resource "nsxt_policy_firewall_session_timer_profile" "profile1" {
+  display_name    = "%s-1"
+  tcp_established = 86400
+}
+
+resource "nsxt_policy_firewall_session_timer_profile" "profile2" {
+  display_name    = "%s-2"
+  tcp_established = 172800
+}
+
+resource "nsxt_policy_firewall_session_timer_profile_gateway_binding" "test" {
+  display_name = "%s"
+  gateway_path = nsxt_policy_tier1_gateway.test.path
+  profile_path = nsxt_policy_firewall_session_timer_profile.%s.path
+}`, name, name, name, profile)
+}
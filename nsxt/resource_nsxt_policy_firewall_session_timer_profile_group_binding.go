@@ -0,0 +1,109 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_groups "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/domains/groups"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains/groups"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var policyFirewallSessionTimerProfileGroupBinding = policyProfileBindingResource{
+	name:               "Firewall Session Timer Profile Binding",
+	parentAttribute:    "group_path",
+	parentDescription:  "Policy path of the group to apply the profile to",
+	profileDescription: "Policy path of firewall session timer profile",
+	sequenceNumberSchema: &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: "Sequence number used to resolve conflicts when multiple profiles apply to a single port. Lower value gets higher precedence",
+		Optional:    true,
+		Default:     0,
+	},
+	importSeparator:     "/firewall-session-timer-profile-binding-maps/",
+	importParentExample: "/infra/domains/default/groups/<group-id>",
+	validateParent:      validatePolicyGroupPath,
+	get:                 getPolicyFirewallSessionTimerProfileGroupBinding,
+	patch:               patchPolicyFirewallSessionTimerProfileGroupBinding,
+	delete:              deletePolicyFirewallSessionTimerProfileGroupBinding,
+}
+
+func resourceNsxtPolicyFirewallSessionTimerProfileGroupBinding() *schema.Resource {
+	return policyFirewallSessionTimerProfileGroupBinding.resource()
+}
+
+func getPolicyFirewallSessionTimerProfileGroupBinding(connector *client.RestConnector, groupPath string, id string, isGlobalManager bool) (policyProfileBinding, error) {
+	domain, groupID, err := parsePolicyGroupPath(groupPath)
+	if err != nil {
+		return policyProfileBinding{}, err
+	}
+
+	var obj model.PolicyFirewallSessionTimerProfileBindingMap
+	if isGlobalManager {
+		gmObj, err := gm_groups.NewFirewallSessionTimerProfileBindingMapsClient(connector).Get(domain, groupID, id)
+		if err != nil {
+			return policyProfileBinding{}, err
+		}
+		rawObj, convErr := convertModelBindingType(gmObj, gm_model.PolicyFirewallSessionTimerProfileBindingMapBindingType(), model.PolicyFirewallSessionTimerProfileBindingMapBindingType())
+		if convErr != nil {
+			return policyProfileBinding{}, convErr
+		}
+		obj = rawObj.(model.PolicyFirewallSessionTimerProfileBindingMap)
+	} else {
+		obj, err = groups.NewFirewallSessionTimerProfileBindingMapsClient(connector).Get(domain, groupID, id)
+		if err != nil {
+			return policyProfileBinding{}, err
+		}
+	}
+
+	return policyProfileBinding{
+		DisplayName:    obj.DisplayName,
+		Description:    obj.Description,
+		Tags:           obj.Tags,
+		Path:           obj.Path,
+		Revision:       obj.Revision,
+		ProfilePath:    obj.FirewallSessionTimerProfilePath,
+		SequenceNumber: obj.SequenceNumber,
+	}, nil
+}
+
+func patchPolicyFirewallSessionTimerProfileGroupBinding(connector *client.RestConnector, groupPath string, id string, binding policyProfileBinding, isGlobalManager bool) error {
+	domain, groupID, err := parsePolicyGroupPath(groupPath)
+	if err != nil {
+		return err
+	}
+
+	obj := model.PolicyFirewallSessionTimerProfileBindingMap{
+		DisplayName:                     binding.DisplayName,
+		Description:                     binding.Description,
+		Tags:                            binding.Tags,
+		Revision:                        binding.Revision,
+		FirewallSessionTimerProfilePath: binding.ProfilePath,
+		SequenceNumber:                  binding.SequenceNumber,
+	}
+
+	if isGlobalManager {
+		gmObj, convErr := convertModelBindingType(obj, model.PolicyFirewallSessionTimerProfileBindingMapBindingType(), gm_model.PolicyFirewallSessionTimerProfileBindingMapBindingType())
+		if convErr != nil {
+			return convErr
+		}
+		return gm_groups.NewFirewallSessionTimerProfileBindingMapsClient(connector).Patch(domain, groupID, id, gmObj.(gm_model.PolicyFirewallSessionTimerProfileBindingMap))
+	}
+
+	return groups.NewFirewallSessionTimerProfileBindingMapsClient(connector).Patch(domain, groupID, id, obj)
+}
+
+func deletePolicyFirewallSessionTimerProfileGroupBinding(connector *client.RestConnector, groupPath string, id string, isGlobalManager bool) error {
+	domain, groupID, err := parsePolicyGroupPath(groupPath)
+	if err != nil {
+		return err
+	}
+
+	if isGlobalManager {
+		return gm_groups.NewFirewallSessionTimerProfileBindingMapsClient(connector).Delete(domain, groupID, id)
+	}
+	return groups.NewFirewallSessionTimerProfileBindingMapsClient(connector).Delete(domain, groupID, id)
+}
@@ -0,0 +1,97 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyFirewallSessionTimerProfileGroupBinding_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_firewall_session_timer_profile_group_binding.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyProfileBindingCheckDestroy(state, "nsxt_policy_firewall_session_timer_profile_group_binding", policyFirewallSessionTimerProfileGroupBinding)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallSessionTimerProfileGroupBindingTemplate(name, "profile1", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyProfileBindingExists(testResourceName, policyFirewallSessionTimerProfileGroupBinding),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "sequence_number", "10"),
+					resource.TestCheckResourceAttrPair(testResourceName, "profile_path", "nsxt_policy_firewall_session_timer_profile.profile1", "path"),
+					resource.TestCheckResourceAttrPair(testResourceName, "group_path", "nsxt_policy_group.test", "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyFirewallSessionTimerProfileGroupBindingTemplate(name, "profile2", 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyProfileBindingExists(testResourceName, policyFirewallSessionTimerProfileGroupBinding),
+					resource.TestCheckResourceAttr(testResourceName, "sequence_number", "20"),
+					resource.TestCheckResourceAttrPair(testResourceName, "profile_path", "nsxt_policy_firewall_session_timer_profile.profile2", "path"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyFirewallSessionTimerProfileGroupBinding_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_firewall_session_timer_profile_group_binding.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyProfileBindingCheckDestroy(state, "nsxt_policy_firewall_session_timer_profile_group_binding", policyFirewallSessionTimerProfileGroupBinding)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallSessionTimerProfileGroupBindingTemplate(name, "profile1", 10),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyPathImporterGetID(testResourceName),
+			},
+		},
+	})
+}
+
+// Import ID of nested policy objects is their policy path
+func testAccNsxtPolicyFirewallSessionTimerProfileGroupBindingTemplate(name string, profile string, sequenceNumber int) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_firewall_session_timer_profile" "profile1" {
+  display_name    = "%s-1"
+  tcp_established = 86400
+}
+
+resource "nsxt_policy_firewall_session_timer_profile" "profile2" {
+  display_name    = "%s-2"
+  tcp_established = 172800
+}
+
+resource "nsxt_policy_firewall_session_timer_profile_group_binding" "test" {
+  display_name    = "%s"
+  group_path      = nsxt_policy_group.test.path
+  profile_path    = nsxt_policy_firewall_session_timer_profile.%s.path
+  sequence_number = %d
+}`, name, name, name, name, profile, sequenceNumber)
+}
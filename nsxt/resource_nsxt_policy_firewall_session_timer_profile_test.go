@@ -0,0 +1,139 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyFirewallSessionTimerProfileCreateAttributes = map[string]string{
+	"display_name":     getAccTestResourceName(),
+	"description":      "terraform created",
+	"tcp_established":  "86400",
+	"tcp_first_packet": "60",
+	"udp_single":       "20",
+}
+
+var accTestPolicyFirewallSessionTimerProfileUpdateAttributes = map[string]string{
+	"display_name":     getAccTestResourceName(),
+	"description":      "terraform updated",
+	"tcp_established":  "172800",
+	"tcp_first_packet": "90",
+	"udp_single":       "40",
+}
+
+func TestAccResourceNsxtPolicyFirewallSessionTimerProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_firewall_session_timer_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, accTestPolicyFirewallSessionTimerProfileUpdateAttributes["display_name"], "nsxt_policy_firewall_session_timer_profile", resourceNsxtPolicyFirewallSessionTimerProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallSessionTimerProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyFirewallSessionTimerProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyFirewallSessionTimerProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyFirewallSessionTimerProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "tcp_established", accTestPolicyFirewallSessionTimerProfileCreateAttributes["tcp_established"]),
+					resource.TestCheckResourceAttr(testResourceName, "tcp_first_packet", accTestPolicyFirewallSessionTimerProfileCreateAttributes["tcp_first_packet"]),
+					resource.TestCheckResourceAttr(testResourceName, "udp_single", accTestPolicyFirewallSessionTimerProfileCreateAttributes["udp_single"]),
+					resource.TestCheckResourceAttr(testResourceName, "icmp_error_reply", "10"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyFirewallSessionTimerProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyFirewallSessionTimerProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyFirewallSessionTimerProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyFirewallSessionTimerProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "tcp_established", accTestPolicyFirewallSessionTimerProfileUpdateAttributes["tcp_established"]),
+					resource.TestCheckResourceAttr(testResourceName, "tcp_first_packet", accTestPolicyFirewallSessionTimerProfileUpdateAttributes["tcp_first_packet"]),
+					resource.TestCheckResourceAttr(testResourceName, "udp_single", accTestPolicyFirewallSessionTimerProfileUpdateAttributes["udp_single"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyFirewallSessionTimerProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyFirewallSessionTimerProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "tcp_established", "43200"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyFirewallSessionTimerProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_firewall_session_timer_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_firewall_session_timer_profile", resourceNsxtPolicyFirewallSessionTimerProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFirewallSessionTimerProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyFirewallSessionTimerProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyFirewallSessionTimerProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyFirewallSessionTimerProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_firewall_session_timer_profile" "test" {
+  display_name     = "%s"
+  description      = "%s"
+  tcp_established  = %s
+  tcp_first_packet = %s
+  udp_single       = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["tcp_established"], attrMap["tcp_first_packet"], attrMap["udp_single"])
+}
+
+func testAccNsxtPolicyFirewallSessionTimerProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_firewall_session_timer_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyFirewallSessionTimerProfileUpdateAttributes["display_name"])
+}
@@ -0,0 +1,170 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_tier0s "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/tier_0s"
+	gm_t0_locale_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/tier_0s/locale_services"
+	gm_tier1s "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/tier_1s"
+	gm_t1_locale_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/tier_1s/locale_services"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s"
+	t0_locale_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s"
+	t1_locale_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/locale_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var policyFloodProtectionProfileGatewayBinding = policyProfileBindingResource{
+	name:                "Flood Protection Profile Gateway Binding",
+	parentAttribute:     "gateway_path",
+	parentDescription:   "Policy path of Tier-0 or Tier-1 gateway, or of gateway locale service, to apply the profile to",
+	profileDescription:  "Policy path of gateway flood protection profile",
+	importSeparator:     "/flood-protection-profile-bindings/",
+	importParentExample: "/infra/tier-1s/<gateway-id>",
+	validateParent:      validatePolicyGatewayOrLocaleServicePath,
+	get:                 getPolicyFloodProtectionProfileGatewayBinding,
+	patch:               patchPolicyFloodProtectionProfileGatewayBinding,
+	delete:              deletePolicyFloodProtectionProfileGatewayBinding,
+}
+
+func resourceNsxtPolicyFloodProtectionProfileGatewayBinding() *schema.Resource {
+	return policyFloodProtectionProfileGatewayBinding.resource()
+}
+
+func getPolicyFloodProtectionProfileGatewayBindingGM(connector *client.RestConnector, isT0 bool, gwID string, localeServiceID string, id string) (model.FloodProtectionProfileBindingMap, error) {
+	var gmObj gm_model.FloodProtectionProfileBindingMap
+	var err error
+	if localeServiceID != "" {
+		if isT0 {
+			gmObj, err = gm_t0_locale_services.NewFloodProtectionProfileBindingsClient(connector).Get(gwID, localeServiceID, id)
+		} else {
+			gmObj, err = gm_t1_locale_services.NewFloodProtectionProfileBindingsClient(connector).Get(gwID, localeServiceID, id)
+		}
+	} else if isT0 {
+		gmObj, err = gm_tier0s.NewFloodProtectionProfileBindingsClient(connector).Get(gwID, id)
+	} else {
+		gmObj, err = gm_tier1s.NewFloodProtectionProfileBindingsClient(connector).Get(gwID, id)
+	}
+	if err != nil {
+		return model.FloodProtectionProfileBindingMap{}, err
+	}
+
+	rawObj, convErr := convertModelBindingType(gmObj, gm_model.FloodProtectionProfileBindingMapBindingType(), model.FloodProtectionProfileBindingMapBindingType())
+	if convErr != nil {
+		return model.FloodProtectionProfileBindingMap{}, convErr
+	}
+	return rawObj.(model.FloodProtectionProfileBindingMap), nil
+}
+
+func getPolicyFloodProtectionProfileGatewayBinding(connector *client.RestConnector, gwPath string, id string, isGlobalManager bool) (policyProfileBinding, error) {
+	isT0, gwID, localeServiceID, err := parseGatewayOrLocaleServicePolicyPath(gwPath)
+	if err != nil {
+		return policyProfileBinding{}, err
+	}
+
+	var obj model.FloodProtectionProfileBindingMap
+	if isGlobalManager {
+		obj, err = getPolicyFloodProtectionProfileGatewayBindingGM(connector, isT0, gwID, localeServiceID, id)
+	} else if localeServiceID != "" {
+		if isT0 {
+			obj, err = t0_locale_services.NewFloodProtectionProfileBindingsClient(connector).Get(gwID, localeServiceID, id)
+		} else {
+			obj, err = t1_locale_services.NewFloodProtectionProfileBindingsClient(connector).Get(gwID, localeServiceID, id)
+		}
+	} else if isT0 {
+		obj, err = tier_0s.NewFloodProtectionProfileBindingsClient(connector).Get(gwID, id)
+	} else {
+		obj, err = tier_1s.NewFloodProtectionProfileBindingsClient(connector).Get(gwID, id)
+	}
+	if err != nil {
+		return policyProfileBinding{}, err
+	}
+
+	return policyProfileBinding{
+		DisplayName: obj.DisplayName,
+		Description: obj.Description,
+		Tags:        obj.Tags,
+		Path:        obj.Path,
+		Revision:    obj.Revision,
+		ProfilePath: obj.ProfilePath,
+	}, nil
+}
+
+func patchPolicyFloodProtectionProfileGatewayBinding(connector *client.RestConnector, gwPath string, id string, binding policyProfileBinding, isGlobalManager bool) error {
+	isT0, gwID, localeServiceID, err := parseGatewayOrLocaleServicePolicyPath(gwPath)
+	if err != nil {
+		return err
+	}
+
+	obj := model.FloodProtectionProfileBindingMap{
+		DisplayName: binding.DisplayName,
+		Description: binding.Description,
+		Tags:        binding.Tags,
+		Revision:    binding.Revision,
+		ProfilePath: binding.ProfilePath,
+	}
+
+	if isGlobalManager {
+		rawObj, convErr := convertModelBindingType(obj, model.FloodProtectionProfileBindingMapBindingType(), gm_model.FloodProtectionProfileBindingMapBindingType())
+		if convErr != nil {
+			return convErr
+		}
+		gmObj := rawObj.(gm_model.FloodProtectionProfileBindingMap)
+		if localeServiceID != "" {
+			if isT0 {
+				return gm_t0_locale_services.NewFloodProtectionProfileBindingsClient(connector).Patch(gwID, localeServiceID, id, gmObj)
+			}
+			return gm_t1_locale_services.NewFloodProtectionProfileBindingsClient(connector).Patch(gwID, localeServiceID, id, gmObj)
+		}
+		if isT0 {
+			return gm_tier0s.NewFloodProtectionProfileBindingsClient(connector).Patch(gwID, id, gmObj)
+		}
+		return gm_tier1s.NewFloodProtectionProfileBindingsClient(connector).Patch(gwID, id, gmObj)
+	}
+
+	if localeServiceID != "" {
+		if isT0 {
+			return t0_locale_services.NewFloodProtectionProfileBindingsClient(connector).Patch(gwID, localeServiceID, id, obj)
+		}
+		return t1_locale_services.NewFloodProtectionProfileBindingsClient(connector).Patch(gwID, localeServiceID, id, obj)
+	}
+	if isT0 {
+		return tier_0s.NewFloodProtectionProfileBindingsClient(connector).Patch(gwID, id, obj)
+	}
+	return tier_1s.NewFloodProtectionProfileBindingsClient(connector).Patch(gwID, id, obj)
+}
+
+func deletePolicyFloodProtectionProfileGatewayBinding(connector *client.RestConnector, gwPath string, id string, isGlobalManager bool) error {
+	isT0, gwID, localeServiceID, err := parseGatewayOrLocaleServicePolicyPath(gwPath)
+	if err != nil {
+		return err
+	}
+
+	if isGlobalManager {
+		if localeServiceID != "" {
+			if isT0 {
+				return gm_t0_locale_services.NewFloodProtectionProfileBindingsClient(connector).Delete(gwID, localeServiceID, id)
+			}
+			return gm_t1_locale_services.NewFloodProtectionProfileBindingsClient(connector).Delete(gwID, localeServiceID, id)
+		}
+		if isT0 {
+			return gm_tier0s.NewFloodProtectionProfileBindingsClient(connector).Delete(gwID, id)
+		}
+		return gm_tier1s.NewFloodProtectionProfileBindingsClient(connector).Delete(gwID, id)
+	}
+
+	if localeServiceID != "" {
+		if isT0 {
+			return t0_locale_services.NewFloodProtectionProfileBindingsClient(connector).Delete(gwID, localeServiceID, id)
+		}
+		return t1_locale_services.NewFloodProtectionProfileBindingsClient(connector).Delete(gwID, localeServiceID, id)
+	}
+	if isT0 {
+		return tier_0s.NewFloodProtectionProfileBindingsClient(connector).Delete(gwID, id)
+	}
+	return tier_1s.NewFloodProtectionProfileBindingsClient(connector).Delete(gwID, id)
+}
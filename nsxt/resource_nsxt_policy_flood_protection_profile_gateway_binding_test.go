@@ -0,0 +1,126 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyFloodProtectionProfileGatewayBinding_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_flood_protection_profile_gateway_binding.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyProfileBindingCheckDestroy(state, "nsxt_policy_flood_protection_profile_gateway_binding", policyFloodProtectionProfileGatewayBinding)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFloodProtectionProfileGatewayBindingTemplate(name, "profile1", "nsxt_policy_tier1_gateway.test.path"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyProfileBindingExists(testResourceName, policyFloodProtectionProfileGatewayBinding),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttrPair(testResourceName, "profile_path", "nsxt_policy_gateway_flood_protection_profile.profile1", "path"),
+					resource.TestCheckResourceAttrPair(testResourceName, "gateway_path", "nsxt_policy_tier1_gateway.test", "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyFloodProtectionProfileGatewayBindingTemplate(name, "profile2", "nsxt_policy_tier1_gateway.test.path"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyProfileBindingExists(testResourceName, policyFloodProtectionProfileGatewayBinding),
+					resource.TestCheckResourceAttrPair(testResourceName, "profile_path", "nsxt_policy_gateway_flood_protection_profile.profile2", "path"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyFloodProtectionProfileGatewayBinding_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_flood_protection_profile_gateway_binding.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyProfileBindingCheckDestroy(state, "nsxt_policy_flood_protection_profile_gateway_binding", policyFloodProtectionProfileGatewayBinding)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFloodProtectionProfileGatewayBindingTemplate(name, "profile1", "nsxt_policy_tier1_gateway.test.path"),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyPathImporterGetID(testResourceName),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyFloodProtectionProfileGatewayBinding_localeService(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_flood_protection_profile_gateway_binding.test"
+	gatewayPath := fmt.Sprintf("\"${nsxt_policy_tier1_gateway.test.path}/locale-services/%s\"", defaultPolicyLocaleServiceID)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyProfileBindingCheckDestroy(state, "nsxt_policy_flood_protection_profile_gateway_binding", policyFloodProtectionProfileGatewayBinding)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyFloodProtectionProfileGatewayBindingTemplate(name, "profile1", gatewayPath),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyProfileBindingExists(testResourceName, policyFloodProtectionProfileGatewayBinding),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttrPair(testResourceName, "profile_path", "nsxt_policy_gateway_flood_protection_profile.profile1", "path"),
+					resource.TestMatchResourceAttr(testResourceName, "gateway_path", regexp.MustCompile("/locale-services/"+defaultPolicyLocaleServiceID+"$")),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyPathImporterGetID(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyFloodProtectionProfileGatewayBindingTemplate(name string, profile string, gatewayPath string) string {
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) +
+		testAccNsxtPolicyTier1WithEdgeClusterTemplate("test", false) + fmt.Sprintf(`
+resource "nsxt_policy_gateway_flood_protection_profile" "profile1" {
+  display_name             = "%s-1"
+  tcp_half_open_conn_limit = 1000
+}
+
+resource "nsxt_policy_gateway_flood_protection_profile" "profile2" {
+  display_name             = "%s-2"
+  tcp_half_open_conn_limit = 2000
+}
+
+resource "nsxt_policy_flood_protection_profile_gateway_binding" "test" {
+  display_name = "%s"
+  gateway_path = %s
+  profile_path = nsxt_policy_gateway_flood_protection_profile.%s.path
+}`, name, name, name, gatewayPath, profile)
+}
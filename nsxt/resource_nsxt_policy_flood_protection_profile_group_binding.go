@@ -0,0 +1,109 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_groups "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/domains/groups"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains/groups"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var policyFloodProtectionProfileGroupBinding = policyProfileBindingResource{
+	name:               "Flood Protection Profile Binding",
+	parentAttribute:    "group_path",
+	parentDescription:  "Policy path of the group to apply the profile to",
+	profileDescription: "Policy path of distributed flood protection profile",
+	sequenceNumberSchema: &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: "Sequence number used to resolve conflicts when multiple profiles apply to a single port. Lower value gets higher precedence",
+		Optional:    true,
+		Default:     0,
+	},
+	importSeparator:     "/firewall-flood-protection-profile-binding-maps/",
+	importParentExample: "/infra/domains/default/groups/<group-id>",
+	validateParent:      validatePolicyGroupPath,
+	get:                 getPolicyFloodProtectionProfileGroupBinding,
+	patch:               patchPolicyFloodProtectionProfileGroupBinding,
+	delete:              deletePolicyFloodProtectionProfileGroupBinding,
+}
+
+func resourceNsxtPolicyFloodProtectionProfileGroupBinding() *schema.Resource {
+	return policyFloodProtectionProfileGroupBinding.resource()
+}
+
+func getPolicyFloodProtectionProfileGroupBinding(connector *client.RestConnector, groupPath string, id string, isGlobalManager bool) (policyProfileBinding, error) {
+	domain, groupID, err := parsePolicyGroupPath(groupPath)
+	if err != nil {
+		return policyProfileBinding{}, err
+	}
+
+	var obj model.PolicyFirewallFloodProtectionProfileBindingMap
+	if isGlobalManager {
+		gmObj, err := gm_groups.NewFirewallFloodProtectionProfileBindingMapsClient(connector).Get(domain, groupID, id)
+		if err != nil {
+			return policyProfileBinding{}, err
+		}
+		rawObj, convErr := convertModelBindingType(gmObj, gm_model.PolicyFirewallFloodProtectionProfileBindingMapBindingType(), model.PolicyFirewallFloodProtectionProfileBindingMapBindingType())
+		if convErr != nil {
+			return policyProfileBinding{}, convErr
+		}
+		obj = rawObj.(model.PolicyFirewallFloodProtectionProfileBindingMap)
+	} else {
+		obj, err = groups.NewFirewallFloodProtectionProfileBindingMapsClient(connector).Get(domain, groupID, id)
+		if err != nil {
+			return policyProfileBinding{}, err
+		}
+	}
+
+	return policyProfileBinding{
+		DisplayName:    obj.DisplayName,
+		Description:    obj.Description,
+		Tags:           obj.Tags,
+		Path:           obj.Path,
+		Revision:       obj.Revision,
+		ProfilePath:    obj.ProfilePath,
+		SequenceNumber: obj.SequenceNumber,
+	}, nil
+}
+
+func patchPolicyFloodProtectionProfileGroupBinding(connector *client.RestConnector, groupPath string, id string, binding policyProfileBinding, isGlobalManager bool) error {
+	domain, groupID, err := parsePolicyGroupPath(groupPath)
+	if err != nil {
+		return err
+	}
+
+	obj := model.PolicyFirewallFloodProtectionProfileBindingMap{
+		DisplayName:    binding.DisplayName,
+		Description:    binding.Description,
+		Tags:           binding.Tags,
+		Revision:       binding.Revision,
+		ProfilePath:    binding.ProfilePath,
+		SequenceNumber: binding.SequenceNumber,
+	}
+
+	if isGlobalManager {
+		gmObj, convErr := convertModelBindingType(obj, model.PolicyFirewallFloodProtectionProfileBindingMapBindingType(), gm_model.PolicyFirewallFloodProtectionProfileBindingMapBindingType())
+		if convErr != nil {
+			return convErr
+		}
+		return gm_groups.NewFirewallFloodProtectionProfileBindingMapsClient(connector).Patch(domain, groupID, id, gmObj.(gm_model.PolicyFirewallFloodProtectionProfileBindingMap))
+	}
+
+	return groups.NewFirewallFloodProtectionProfileBindingMapsClient(connector).Patch(domain, groupID, id, obj)
+}
+
+func deletePolicyFloodProtectionProfileGroupBinding(connector *client.RestConnector, groupPath string, id string, isGlobalManager bool) error {
+	domain, groupID, err := parsePolicyGroupPath(groupPath)
+	if err != nil {
+		return err
+	}
+
+	if isGlobalManager {
+		return gm_groups.NewFirewallFloodProtectionProfileBindingMapsClient(connector).Delete(domain, groupID, id)
+	}
+	return groups.NewFirewallFloodProtectionProfileBindingMapsClient(connector).Delete(domain, groupID, id)
+}
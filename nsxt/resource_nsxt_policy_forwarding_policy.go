@@ -0,0 +1,263 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+// Forwarding API does not support dropping traffic, this should be done
+// with security or gateway policy rules
+var forwardingRuleActionValues = []string{
+	model.ForwardingRule_ACTION_ROUTE_TO_UNDERLAY,
+	model.ForwardingRule_ACTION_ROUTE_FROM_UNDERLAY,
+}
+
+func getPolicyForwardingPolicySchema() map[string]*schema.Schema {
+	policySchema := getPolicySecurityPolicySchema(false)
+	// Forwarding policies don't support category or scheduling
+	delete(policySchema, "category")
+	delete(policySchema, "schedule_path")
+	delete(policySchema, "stateful")
+	delete(policySchema, "tcp_strict")
+
+	ruleSchema := getSecurityPolicyAndGatewayRulesSchema(false, false)
+	ruleSchema.Elem.(*schema.Resource).Schema["action"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Action",
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(forwardingRuleActionValues, false),
+		Default:      model.ForwardingRule_ACTION_ROUTE_TO_UNDERLAY,
+	}
+	policySchema["rule"] = ruleSchema
+
+	return policySchema
+}
+
+func resourceNsxtPolicyForwardingPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyForwardingPolicyCreate,
+		Read:   resourceNsxtPolicyForwardingPolicyRead,
+		Update: resourceNsxtPolicyForwardingPolicyUpdate,
+		Delete: resourceNsxtPolicyForwardingPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtDomainResourceImporter,
+		},
+		Schema: getPolicyForwardingPolicySchema(),
+	}
+}
+
+func resourceNsxtPolicyForwardingPolicyExistsInDomain(id string, domainName string, connector *client.RestConnector) (bool, error) {
+	client := domains.NewForwardingPoliciesClient(connector)
+	_, err := client.Get(domainName, id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Forwarding Policy", err)
+}
+
+func resourceNsxtPolicyForwardingPolicyExistsPartial(domainName string) func(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	return func(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+		return resourceNsxtPolicyForwardingPolicyExistsInDomain(id, domainName, connector)
+	}
+}
+
+func getPolicyForwardingRulesFromSchema(d *schema.ResourceData) []model.ForwardingRule {
+	var ruleList []model.ForwardingRule
+	// Forwarding rules share all attributes with firewall rules but action
+	for _, rule := range getPolicyRulesFromSchema(d) {
+		resourceType := "ForwardingRule"
+		ruleList = append(ruleList, model.ForwardingRule{
+			ResourceType:         &resourceType,
+			Id:                   rule.Id,
+			DisplayName:          rule.DisplayName,
+			Description:          rule.Description,
+			Notes:                rule.Notes,
+			Logged:               rule.Logged,
+			Tag:                  rule.Tag,
+			Tags:                 rule.Tags,
+			Action:               rule.Action,
+			Disabled:             rule.Disabled,
+			SourcesExcluded:      rule.SourcesExcluded,
+			DestinationsExcluded: rule.DestinationsExcluded,
+			IpProtocol:           rule.IpProtocol,
+			Direction:            rule.Direction,
+			SourceGroups:         rule.SourceGroups,
+			DestinationGroups:    rule.DestinationGroups,
+			Services:             rule.Services,
+			Scope:                rule.Scope,
+			Profiles:             rule.Profiles,
+			SequenceNumber:       rule.SequenceNumber,
+		})
+	}
+
+	return ruleList
+}
+
+func setPolicyForwardingRulesInSchema(d *schema.ResourceData, rules []model.ForwardingRule) error {
+	var rulesList []map[string]interface{}
+	for _, rule := range rules {
+		elem := getPolicyRuleElemFromModel(model.Rule{
+			Id:                   rule.Id,
+			DisplayName:          rule.DisplayName,
+			Description:          rule.Description,
+			Revision:             rule.Revision,
+			Notes:                rule.Notes,
+			Logged:               rule.Logged,
+			Tag:                  rule.Tag,
+			Tags:                 rule.Tags,
+			Action:               rule.Action,
+			Disabled:             rule.Disabled,
+			SourcesExcluded:      rule.SourcesExcluded,
+			DestinationsExcluded: rule.DestinationsExcluded,
+			IpProtocol:           rule.IpProtocol,
+			Direction:            rule.Direction,
+			SourceGroups:         rule.SourceGroups,
+			DestinationGroups:    rule.DestinationGroups,
+			Services:             rule.Services,
+			Scope:                rule.Scope,
+			Profiles:             rule.Profiles,
+			SequenceNumber:       rule.SequenceNumber,
+			RuleId:               rule.RuleId,
+		})
+		rulesList = append(rulesList, elem)
+	}
+
+	return d.Set("rule", rulesList)
+}
+
+func getPolicyForwardingPolicyFromSchema(d *schema.ResourceData) model.ForwardingPolicy {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	comments := d.Get("comments").(string)
+	locked := d.Get("locked").(bool)
+	sequenceNumber := int64(d.Get("sequence_number").(int))
+	resourceType := "ForwardingPolicy"
+
+	return model.ForwardingPolicy{
+		DisplayName:    &displayName,
+		Description:    &description,
+		Tags:           tags,
+		Comments:       &comments,
+		Locked:         &locked,
+		Scope:          getStringListFromSchemaSet(d, "scope"),
+		SequenceNumber: &sequenceNumber,
+		ResourceType:   &resourceType,
+		Rules:          getPolicyForwardingRulesFromSchema(d),
+	}
+}
+
+func resourceNsxtPolicyForwardingPolicyCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return resourceNotSupportedError()
+	}
+
+	connector := getPolicyConnector(m)
+	domain := d.Get("domain").(string)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyForwardingPolicyExistsPartial(domain))
+	if err != nil {
+		return err
+	}
+
+	obj := getPolicyForwardingPolicyFromSchema(d)
+
+	// Create the resource using PATCH
+	log.Printf("[INFO] Creating Forwarding Policy with ID %s", id)
+	client := domains.NewForwardingPoliciesClient(connector)
+	err = client.Patch(domain, id, obj)
+	if err != nil {
+		return handleCreateError("Forwarding Policy", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyForwardingPolicyRead(d, m)
+}
+
+func resourceNsxtPolicyForwardingPolicyRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	domain := d.Get("domain").(string)
+	if id == "" {
+		return fmt.Errorf("Error obtaining Forwarding Policy ID")
+	}
+
+	client := domains.NewForwardingPoliciesClient(connector)
+	obj, err := client.Get(domain, id)
+	if err != nil {
+		return handleReadError(d, "Forwarding Policy", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("domain", getDomainFromResourcePath(*obj.Path))
+	d.Set("comments", obj.Comments)
+	d.Set("locked", obj.Locked)
+	d.Set("scope", obj.Scope)
+	d.Set("sequence_number", obj.SequenceNumber)
+
+	return setPolicyForwardingRulesInSchema(d, obj.Rules)
+}
+
+func resourceNsxtPolicyForwardingPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	domain := d.Get("domain").(string)
+	if id == "" {
+		return fmt.Errorf("Error obtaining Forwarding Policy ID")
+	}
+
+	obj := getPolicyForwardingPolicyFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+
+	// Update the resource using PUT, so that rules removed from configuration
+	// are deleted on NSX
+	client := domains.NewForwardingPoliciesClient(connector)
+	_, err := client.Update(domain, id, obj)
+	if err != nil {
+		return handleUpdateError("Forwarding Policy", id, err)
+	}
+
+	return resourceNsxtPolicyForwardingPolicyRead(d, m)
+}
+
+func resourceNsxtPolicyForwardingPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	domain := d.Get("domain").(string)
+	if id == "" {
+		return fmt.Errorf("Error obtaining Forwarding Policy ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := domains.NewForwardingPoliciesClient(connector)
+	err := client.Delete(domain, id)
+	if err != nil {
+		return handleDeleteError("Forwarding Policy", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyForwardingPolicy_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_policy_forwarding_policy.test"
+	existsFunc := resourceNsxtPolicyForwardingPolicyExistsPartial(defaultDomain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, updatedName, "nsxt_policy_forwarding_policy", existsFunc)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyForwardingPolicyTemplate(name, 3, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, existsFunc),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "domain", defaultDomain),
+					resource.TestCheckResourceAttr(testResourceName, "sequence_number", "3"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", "rule1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.action", "ROUTE_TO_UNDERLAY"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.sequence_number", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.display_name", "rule2"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.action", "ROUTE_FROM_UNDERLAY"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.sequence_number", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyForwardingPolicyTemplate(updatedName, 5, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, existsFunc),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "sequence_number", "5"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", "rule1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyForwardingPolicy_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_forwarding_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_forwarding_policy", resourceNsxtPolicyForwardingPolicyExistsPartial(defaultDomain))
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyForwardingPolicyTemplate(name, 3, true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyForwardingPolicyTemplate(name string, sequenceNumber int, withSecondRule bool) string {
+	secondRule := ""
+	if withSecondRule {
+		secondRule = `
+  rule {
+    display_name       = "rule2"
+    destination_groups = [nsxt_policy_group.test.path]
+    action             = "ROUTE_FROM_UNDERLAY"
+    logged             = true
+  }`
+	}
+
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_forwarding_policy" "test" {
+  display_name    = "%s"
+  description     = "Acceptance Test"
+  sequence_number = %d
+
+  rule {
+    display_name  = "rule1"
+    source_groups = [nsxt_policy_group.test.path]
+    action        = "ROUTE_TO_UNDERLAY"
+  }
+%s
+}`, name, name, sequenceNumber, secondRule)
+}
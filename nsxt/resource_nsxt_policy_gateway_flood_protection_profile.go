@@ -0,0 +1,34 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceNsxtPolicyGatewayFloodProtectionProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyGatewayFloodProtectionProfileCreate,
+		Read:   resourceNsxtPolicyGatewayFloodProtectionProfileRead,
+		Update: resourceNsxtPolicyGatewayFloodProtectionProfileUpdate,
+		Delete: resourceNsxtPolicyFloodProtectionProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: getFloodProtectionProfileSchema(true),
+	}
+}
+
+func resourceNsxtPolicyGatewayFloodProtectionProfileCreate(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyFloodProtectionProfileCreate(d, m, true)
+}
+
+func resourceNsxtPolicyGatewayFloodProtectionProfileRead(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyFloodProtectionProfileRead(d, m, true)
+}
+
+func resourceNsxtPolicyGatewayFloodProtectionProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyFloodProtectionProfileUpdate(d, m, true)
+}
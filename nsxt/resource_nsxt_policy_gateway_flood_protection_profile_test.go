@@ -0,0 +1,138 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyGatewayFloodProtectionProfileCreateAttributes = map[string]string{
+	"display_name":             getAccTestResourceName(),
+	"description":              "terraform created",
+	"tcp_half_open_conn_limit": "1000",
+	"udp_active_flow_limit":    "2000",
+	"nat_active_conn_limit":    "5000",
+}
+
+var accTestPolicyGatewayFloodProtectionProfileUpdateAttributes = map[string]string{
+	"display_name":             getAccTestResourceName(),
+	"description":              "terraform updated",
+	"tcp_half_open_conn_limit": "3000",
+	"udp_active_flow_limit":    "4000",
+	"nat_active_conn_limit":    "6000",
+}
+
+func TestAccResourceNsxtPolicyGatewayFloodProtectionProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_gateway_flood_protection_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, accTestPolicyGatewayFloodProtectionProfileUpdateAttributes["display_name"], "nsxt_policy_gateway_flood_protection_profile", resourceNsxtPolicyFloodProtectionProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGatewayFloodProtectionProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyFloodProtectionProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyGatewayFloodProtectionProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyGatewayFloodProtectionProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "tcp_half_open_conn_limit", accTestPolicyGatewayFloodProtectionProfileCreateAttributes["tcp_half_open_conn_limit"]),
+					resource.TestCheckResourceAttr(testResourceName, "udp_active_flow_limit", accTestPolicyGatewayFloodProtectionProfileCreateAttributes["udp_active_flow_limit"]),
+					resource.TestCheckResourceAttr(testResourceName, "nat_active_conn_limit", accTestPolicyGatewayFloodProtectionProfileCreateAttributes["nat_active_conn_limit"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyGatewayFloodProtectionProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyFloodProtectionProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyGatewayFloodProtectionProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyGatewayFloodProtectionProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "tcp_half_open_conn_limit", accTestPolicyGatewayFloodProtectionProfileUpdateAttributes["tcp_half_open_conn_limit"]),
+					resource.TestCheckResourceAttr(testResourceName, "udp_active_flow_limit", accTestPolicyGatewayFloodProtectionProfileUpdateAttributes["udp_active_flow_limit"]),
+					resource.TestCheckResourceAttr(testResourceName, "nat_active_conn_limit", accTestPolicyGatewayFloodProtectionProfileUpdateAttributes["nat_active_conn_limit"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyGatewayFloodProtectionProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyFloodProtectionProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "tcp_half_open_conn_limit", "0"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyGatewayFloodProtectionProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_gateway_flood_protection_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_gateway_flood_protection_profile", resourceNsxtPolicyFloodProtectionProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGatewayFloodProtectionProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGatewayFloodProtectionProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyGatewayFloodProtectionProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyGatewayFloodProtectionProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_gateway_flood_protection_profile" "test" {
+  display_name             = "%s"
+  description              = "%s"
+  tcp_half_open_conn_limit = %s
+  udp_active_flow_limit    = %s
+  nat_active_conn_limit    = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["tcp_half_open_conn_limit"], attrMap["udp_active_flow_limit"], attrMap["nat_active_conn_limit"])
+}
+
+func testAccNsxtPolicyGatewayFloodProtectionProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_gateway_flood_protection_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyGatewayFloodProtectionProfileUpdateAttributes["display_name"])
+}
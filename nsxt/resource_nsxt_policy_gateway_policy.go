@@ -18,10 +18,11 @@ import (
 
 func resourceNsxtPolicyGatewayPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyGatewayPolicyCreate,
-		Read:   resourceNsxtPolicyGatewayPolicyRead,
-		Update: resourceNsxtPolicyGatewayPolicyUpdate,
-		Delete: resourceNsxtPolicyGatewayPolicyDelete,
+		Create:        resourceNsxtPolicyGatewayPolicyCreate,
+		Read:          resourceNsxtPolicyGatewayPolicyRead,
+		Update:        resourceNsxtPolicyGatewayPolicyUpdate,
+		Delete:        resourceNsxtPolicyGatewayPolicyDelete,
+		CustomizeDiff: policyRulesAnalysisCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: nsxtDomainResourceImporter,
 		},
@@ -163,6 +164,15 @@ func policyGatewayPolicyBuildAndPatch(d *schema.ResourceData, m interface{}, con
 		obj.Revision = &revision
 	}
 
+	schedulePath := d.Get("schedule_path").(string)
+	if schedulePath != "" && isGlobalManager {
+		return fmt.Errorf("schedule_path setting is not supported with NSX Global Manager")
+	}
+	if schedulePath != "" || d.HasChange("schedule_path") {
+		// Empty value is sent explicitly in order to detach the schedule
+		obj.SchedulerPath = &schedulePath
+	}
+
 	policyChildren, err := getUpdatedRuleChildren(d)
 	if err != nil {
 		return err
@@ -218,6 +228,7 @@ func resourceNsxtPolicyGatewayPolicyRead(d *schema.ResourceData, m interface{})
 	d.Set("locked", obj.Locked)
 	d.Set("sequence_number", obj.SequenceNumber)
 	d.Set("stateful", obj.Stateful)
+	d.Set("schedule_path", obj.SchedulerPath)
 	if obj.TcpStrict != nil {
 		// tcp_strict is dependant on stateful and maybe nil
 		d.Set("tcp_strict", *obj.TcpStrict)
@@ -58,7 +58,7 @@ func resourceNsxtPolicyGroup() *schema.Resource {
 		Update: resourceNsxtPolicyGroupUpdate,
 		Delete: resourceNsxtPolicyGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: nsxtDomainResourceImporter,
+			State: nsxtDomainResourcePromotedImporter("groups"),
 		},
 
 		Schema: map[string]*schema.Schema{
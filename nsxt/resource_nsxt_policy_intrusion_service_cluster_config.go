@@ -0,0 +1,134 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall/security/intrusion_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyIntrusionServiceClusterConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyIntrusionServiceClusterConfigCreate,
+		Read:   resourceNsxtPolicyIntrusionServiceClusterConfigRead,
+		Update: resourceNsxtPolicyIntrusionServiceClusterConfigUpdate,
+		Delete: resourceNsxtPolicyIntrusionServiceClusterConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path":     getPathSchema(),
+			"revision": getRevisionSchema(),
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Description: "ID of compute collection (cluster) to configure",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ids_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Flag to enable IDS/IPS on the cluster",
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+}
+
+func patchNsxtPolicyIntrusionServiceClusterConfig(connector *client.RestConnector, clusterID string, enabled bool) error {
+	obj := model.IdsClusterConfig{
+		IdsEnabled: &enabled,
+		Cluster: &model.PolicyResourceReference{
+			TargetId: &clusterID,
+		},
+	}
+
+	client := intrusion_services.NewClusterConfigsClient(connector)
+	return client.Patch(clusterID, obj)
+}
+
+func resourceNsxtPolicyIntrusionServiceClusterConfigCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return resourceNotSupportedError()
+	}
+
+	connector := getPolicyConnector(m)
+	clusterID := d.Get("cluster_id").(string)
+
+	log.Printf("[INFO] Creating Intrusion Service Cluster Config for cluster %s", clusterID)
+	err := patchNsxtPolicyIntrusionServiceClusterConfig(connector, clusterID, d.Get("ids_enabled").(bool))
+	if err != nil {
+		return handleCreateError("Intrusion Service Cluster Config", clusterID, err)
+	}
+
+	d.SetId(clusterID)
+
+	return resourceNsxtPolicyIntrusionServiceClusterConfigRead(d, m)
+}
+
+func resourceNsxtPolicyIntrusionServiceClusterConfigRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Intrusion Service Cluster Config ID")
+	}
+
+	client := intrusion_services.NewClusterConfigsClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "Intrusion Service Cluster Config", id, err)
+	}
+
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("ids_enabled", obj.IdsEnabled)
+	if obj.Cluster != nil && obj.Cluster.TargetId != nil {
+		d.Set("cluster_id", obj.Cluster.TargetId)
+	} else {
+		d.Set("cluster_id", id)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyIntrusionServiceClusterConfigUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Intrusion Service Cluster Config ID")
+	}
+
+	log.Printf("[INFO] Updating Intrusion Service Cluster Config with ID %s", id)
+	err := patchNsxtPolicyIntrusionServiceClusterConfig(connector, id, d.Get("ids_enabled").(bool))
+	if err != nil {
+		return handleUpdateError("Intrusion Service Cluster Config", id, err)
+	}
+
+	return resourceNsxtPolicyIntrusionServiceClusterConfigRead(d, m)
+}
+
+func resourceNsxtPolicyIntrusionServiceClusterConfigDelete(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Intrusion Service Cluster Config ID")
+	}
+
+	// There is no DELETE API for this object - we need to just disable IDS
+	err := patchNsxtPolicyIntrusionServiceClusterConfig(connector, id, false)
+	if err != nil {
+		return handleDeleteError("Intrusion Service Cluster Config", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall/security/intrusion_services"
+)
+
+func TestAccResourceNsxtPolicyIntrusionServiceClusterConfig_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_intrusion_service_cluster_config.test"
+	clusterID := getTestComputeClusterID()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.1.0")
+			testAccEnvDefined(t, "NSXT_TEST_COMPUTE_CLUSTER_ID")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIntrusionServiceClusterConfigCheckDisabled(clusterID)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIntrusionServiceClusterConfigTemplate(clusterID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "cluster_id", clusterID),
+					resource.TestCheckResourceAttr(testResourceName, "ids_enabled", "true"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyIntrusionServiceClusterConfigTemplate(clusterID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "cluster_id", clusterID),
+					resource.TestCheckResourceAttr(testResourceName, "ids_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIntrusionServiceClusterConfigCheckDisabled(clusterID string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	client := intrusion_services.NewClusterConfigsClient(connector)
+	obj, err := client.Get(clusterID)
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+
+	if obj.IdsEnabled != nil && *obj.IdsEnabled {
+		return fmt.Errorf("IDS is still enabled on cluster %s", clusterID)
+	}
+
+	return nil
+}
+
+func testAccNsxtPolicyIntrusionServiceClusterConfigTemplate(clusterID string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_intrusion_service_cluster_config" "test" {
+  cluster_id  = "%s"
+  ids_enabled = %t
+}`, clusterID, enabled)
+}
@@ -0,0 +1,176 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyIntrusionServiceGatewayPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyIntrusionServiceGatewayPolicyCreate,
+		Read:   resourceNsxtPolicyIntrusionServiceGatewayPolicyRead,
+		Update: resourceNsxtPolicyIntrusionServiceGatewayPolicyUpdate,
+		Delete: resourceNsxtPolicyIntrusionServiceGatewayPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtDomainResourceImporter,
+		},
+		Schema: getPolicyIntrusionServiceGatewayPolicySchema(),
+	}
+}
+
+func getPolicyIntrusionServiceGatewayPolicySchema() map[string]*schema.Schema {
+	idsPolicy := getPolicySecurityPolicySchema(true)
+	// Gateway IDS rules require scope to be set
+	idsPolicy["rule"] = getSecurityPolicyAndGatewayRulesSchema(true, true)
+	return idsPolicy
+}
+
+func resourceNsxtPolicyIntrusionServiceGatewayPolicyExistsInDomain(id string, domainName string, connector *client.RestConnector) (bool, error) {
+	client := domains.NewIntrusionServiceGatewayPoliciesClient(connector)
+	_, err := client.Get(domainName, id)
+
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Intrusion Service Gateway Policy", err)
+}
+
+func resourceNsxtPolicyIntrusionServiceGatewayPolicyExistsPartial(domainName string) func(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	return func(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+		return resourceNsxtPolicyIntrusionServiceGatewayPolicyExistsInDomain(id, domainName, connector)
+	}
+}
+
+func getPolicyIntrusionServiceGatewayPolicyFromSchema(d *schema.ResourceData) model.IdsGatewayPolicy {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	comments := d.Get("comments").(string)
+	locked := d.Get("locked").(bool)
+	sequenceNumber := int64(d.Get("sequence_number").(int))
+	stateful := d.Get("stateful").(bool)
+	resourceType := "IdsGatewayPolicy"
+
+	return model.IdsGatewayPolicy{
+		DisplayName:    &displayName,
+		Description:    &description,
+		Tags:           tags,
+		Comments:       &comments,
+		Locked:         &locked,
+		SequenceNumber: &sequenceNumber,
+		Stateful:       &stateful,
+		ResourceType:   &resourceType,
+		Rules:          getPolicyIdsRulesFromSchema(d),
+	}
+}
+
+func resourceNsxtPolicyIntrusionServiceGatewayPolicyCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return resourceNotSupportedError()
+	}
+
+	connector := getPolicyConnector(m)
+	domain := d.Get("domain").(string)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyIntrusionServiceGatewayPolicyExistsPartial(domain))
+	if err != nil {
+		return err
+	}
+
+	obj := getPolicyIntrusionServiceGatewayPolicyFromSchema(d)
+
+	log.Printf("[INFO] Creating Intrusion Service Gateway Policy with ID %s", id)
+	client := domains.NewIntrusionServiceGatewayPoliciesClient(connector)
+	err = client.Patch(domain, id, obj)
+	if err != nil {
+		return handleCreateError("Intrusion Service Gateway Policy", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyIntrusionServiceGatewayPolicyRead(d, m)
+}
+
+func resourceNsxtPolicyIntrusionServiceGatewayPolicyRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	domainName := d.Get("domain").(string)
+	if id == "" {
+		return fmt.Errorf("Error obtaining Intrusion Service Gateway Policy id")
+	}
+
+	client := domains.NewIntrusionServiceGatewayPoliciesClient(connector)
+	obj, err := client.Get(domainName, id)
+	if err != nil {
+		return handleReadError(d, "Intrusion Service Gateway Policy", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("domain", getDomainFromResourcePath(*obj.Path))
+	d.Set("comments", obj.Comments)
+	d.Set("locked", obj.Locked)
+	d.Set("sequence_number", obj.SequenceNumber)
+	d.Set("stateful", obj.Stateful)
+	d.Set("revision", obj.Revision)
+
+	return setPolicyIdsRulesInSchema(d, obj.Rules)
+}
+
+func resourceNsxtPolicyIntrusionServiceGatewayPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	domain := d.Get("domain").(string)
+	if id == "" {
+		return fmt.Errorf("Error obtaining Intrusion Service Gateway Policy id")
+	}
+
+	obj := getPolicyIntrusionServiceGatewayPolicyFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+
+	// Update the resource using PUT, so that rules removed from configuration
+	// are deleted on NSX
+	log.Printf("[INFO] Updating Intrusion Service Gateway Policy with ID %s", id)
+	client := domains.NewIntrusionServiceGatewayPoliciesClient(connector)
+	_, err := client.Update(domain, id, obj)
+	if err != nil {
+		return handleUpdateError("Intrusion Service Gateway Policy", id, err)
+	}
+
+	return resourceNsxtPolicyIntrusionServiceGatewayPolicyRead(d, m)
+}
+
+func resourceNsxtPolicyIntrusionServiceGatewayPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Intrusion Service Gateway Policy id")
+	}
+
+	connector := getPolicyConnector(m)
+	client := domains.NewIntrusionServiceGatewayPoliciesClient(connector)
+	err := client.Delete(d.Get("domain").(string), id)
+	if err != nil {
+		return handleDeleteError("Intrusion Service Gateway Policy", id, err)
+	}
+
+	return nil
+}
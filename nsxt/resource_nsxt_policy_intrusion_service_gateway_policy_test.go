@@ -0,0 +1,120 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccNsxtPolicyIntrusionServiceGatewayPolicyExists(resourceName string) resource.TestCheckFunc {
+	return testAccNsxtPolicyResourceExists(resourceName, resourceNsxtPolicyIntrusionServiceGatewayPolicyExistsPartial(defaultDomain))
+}
+
+func TestAccResourceNsxtPolicyIntrusionServiceGatewayPolicy_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_policy_intrusion_service_gateway_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.2.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, updatedName, "nsxt_policy_intrusion_service_gateway_policy", resourceNsxtPolicyIntrusionServiceGatewayPolicyExistsPartial(defaultDomain))
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIntrusionServiceGatewayPolicyTemplate(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIntrusionServiceGatewayPolicyExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "domain", defaultDomain),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", "rule1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.action", "DETECT"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.ids_profiles.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.scope.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.action", "DETECT_PREVENT"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyIntrusionServiceGatewayPolicyTemplate(updatedName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIntrusionServiceGatewayPolicyExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", "rule1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIntrusionServiceGatewayPolicy_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_intrusion_service_gateway_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.2.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_intrusion_service_gateway_policy", resourceNsxtPolicyIntrusionServiceGatewayPolicyExistsPartial(defaultDomain))
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIntrusionServiceGatewayPolicyTemplate(name, true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIntrusionServiceGatewayPolicyTemplate(name string, withSecondRule bool) string {
+	secondRule := ""
+	if withSecondRule {
+		secondRule = fmt.Sprintf(`
+  rule {
+    display_name = "rule2"
+    action       = "DETECT_PREVENT"
+    ids_profiles = ["%s"]
+    scope        = [nsxt_policy_tier1_gateway.test.path]
+    logged       = true
+  }`, policyDefaultIdsProfilePath)
+	}
+
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) +
+		testAccNsxtPolicyTier1WithEdgeClusterTemplate("test", false) + fmt.Sprintf(`
+
+resource "nsxt_policy_intrusion_service_gateway_policy" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+
+  rule {
+    display_name = "rule1"
+    direction    = "IN"
+    action       = "DETECT"
+    ids_profiles = ["%s"]
+    scope        = [nsxt_policy_tier1_gateway.test.path]
+  }
+%s
+}`, name, policyDefaultIdsProfilePath, secondRule)
+}
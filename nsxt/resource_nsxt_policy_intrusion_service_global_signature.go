@@ -0,0 +1,159 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall/security/intrusion_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var intrusionServiceGlobalSignatureActionValues = []string{
+	model.GlobalIdsSignature_ACTION_ALERT,
+	model.GlobalIdsSignature_ACTION_DROP,
+	model.GlobalIdsSignature_ACTION_REJECT,
+}
+
+func resourceNsxtPolicyIntrusionServiceGlobalSignature() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyIntrusionServiceGlobalSignatureCreate,
+		Read:   resourceNsxtPolicyIntrusionServiceGlobalSignatureRead,
+		Update: resourceNsxtPolicyIntrusionServiceGlobalSignatureUpdate,
+		Delete: resourceNsxtPolicyIntrusionServiceGlobalSignatureDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path":         getPathSchema(),
+			"display_name": getOptionalDisplayNameSchema(true),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"signature_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the IDS signature to override",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Description:  "Action applied to traffic matching this signature. If not set, signature default is used",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(intrusionServiceGlobalSignatureActionValues, false),
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Flag to enable this signature",
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+}
+
+func policyIntrusionServiceGlobalSignaturePatch(d *schema.ResourceData, m interface{}, id string) error {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	enabled := d.Get("enabled").(bool)
+
+	obj := model.GlobalIdsSignature{
+		Description: &description,
+		Tags:        tags,
+		SignatureId: &id,
+		Enable:      &enabled,
+	}
+
+	if displayName != "" {
+		obj.DisplayName = &displayName
+	}
+
+	action := d.Get("action").(string)
+	if action != "" {
+		obj.Action = &action
+	}
+
+	client := intrusion_services.NewGlobalSignaturesClient(getPolicyConnector(m))
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyIntrusionServiceGlobalSignatureCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return resourceNotSupportedError()
+	}
+
+	id := d.Get("signature_id").(string)
+
+	log.Printf("[INFO] Creating Intrusion Service Global Signature with ID %s", id)
+	err := policyIntrusionServiceGlobalSignaturePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("Intrusion Service Global Signature", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceNsxtPolicyIntrusionServiceGlobalSignatureRead(d, m)
+}
+
+func resourceNsxtPolicyIntrusionServiceGlobalSignatureRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Intrusion Service Global Signature ID")
+	}
+
+	client := intrusion_services.NewGlobalSignaturesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "Intrusion Service Global Signature", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("signature_id", id)
+	d.Set("action", obj.Action)
+	d.Set("enabled", obj.Enable)
+
+	return nil
+}
+
+func resourceNsxtPolicyIntrusionServiceGlobalSignatureUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Intrusion Service Global Signature ID")
+	}
+
+	log.Printf("[INFO] Updating Intrusion Service Global Signature with ID %s", id)
+	err := policyIntrusionServiceGlobalSignaturePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("Intrusion Service Global Signature", id, err)
+	}
+
+	return resourceNsxtPolicyIntrusionServiceGlobalSignatureRead(d, m)
+}
+
+func resourceNsxtPolicyIntrusionServiceGlobalSignatureDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Intrusion Service Global Signature ID")
+	}
+
+	// Deleting the override reverts the signature to its default behavior
+	client := intrusion_services.NewGlobalSignaturesClient(getPolicyConnector(m))
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("Intrusion Service Global Signature", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall/security/intrusion_services"
+)
+
+func TestAccResourceNsxtPolicyIntrusionServiceGlobalSignature_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_intrusion_service_global_signature.test"
+	signatureID := getTestIdsSignatureID()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.1.0")
+			testAccEnvDefined(t, "NSXT_TEST_IDS_SIGNATURE_ID")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIntrusionServiceGlobalSignatureCheckDestroy(signatureID)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIntrusionServiceGlobalSignatureTemplate(signatureID, "DROP", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "signature_id", signatureID),
+					resource.TestCheckResourceAttr(testResourceName, "action", "DROP"),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyIntrusionServiceGlobalSignatureTemplate(signatureID, "ALERT", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "action", "ALERT"),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIntrusionServiceGlobalSignatureCheckDestroy(signatureID string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	client := intrusion_services.NewGlobalSignaturesClient(connector)
+	_, err := client.Get(signatureID)
+	if err == nil {
+		return fmt.Errorf("Intrusion Service Global Signature %s still exists", signatureID)
+	}
+
+	if isNotFoundError(err) {
+		return nil
+	}
+
+	return err
+}
+
+func testAccNsxtPolicyIntrusionServiceGlobalSignatureTemplate(signatureID string, action string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_intrusion_service_global_signature" "test" {
+  signature_id = "%s"
+  action       = "%s"
+  enabled      = %t
+}`, signatureID, action, enabled)
+}
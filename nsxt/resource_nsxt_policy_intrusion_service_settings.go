@@ -0,0 +1,237 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall/security"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/settings/firewall/security/intrusion_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyIntrusionServiceSettings() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceNsxtPolicyIntrusionServiceSettingsCreate,
+		Read:          resourceNsxtPolicyIntrusionServiceSettingsRead,
+		Update:        resourceNsxtPolicyIntrusionServiceSettingsUpdate,
+		Delete:        resourceNsxtPolicyIntrusionServiceSettingsDelete,
+		CustomizeDiff: resourceNsxtPolicyIntrusionServiceSettingsCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"revision": getRevisionSchema(),
+			"auto_update_signatures": {
+				Type:        schema.TypeBool,
+				Description: "Flag to automatically download and activate new IDS signature versions",
+				Optional:    true,
+				Default:     true,
+			},
+			"ids_events_to_syslog": {
+				Type:        schema.TypeBool,
+				Description: "Flag to send IDS events to syslog",
+				Optional:    true,
+				Default:     false,
+			},
+			"standalone_hosts_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Flag to enable IDS/IPS on standalone hosts",
+				Optional:    true,
+				Default:     false,
+			},
+			"signature_version": {
+				Type:        schema.TypeString,
+				Description: "Version ID of the active IDS signature version. If set, this version is made active",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyIntrusionServiceSettingsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	// signature_version is computed, hence only explicit configuration
+	// indicates pinned version
+	if d.GetRawConfig().GetAttr("signature_version").IsNull() {
+		return nil
+	}
+
+	if d.Get("auto_update_signatures").(bool) {
+		return fmt.Errorf("signature_version can only be pinned with auto_update_signatures set to false, otherwise NSX activates newer versions as they become available")
+	}
+
+	return nil
+}
+
+func listPolicyIntrusionServiceSignatureVersions(connector *client.RestConnector) ([]model.IdsSignatureVersion, error) {
+	client := intrusion_services.NewSignatureVersionsClient(connector)
+
+	var results []model.IdsSignatureVersion
+	var cursor *string
+	total := 0
+
+	for {
+		versions, err := client.List(cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, versions.Results...)
+		if len(versions.Results) == 0 {
+			// no more results
+			return results, nil
+		}
+		if total == 0 && versions.ResultCount != nil {
+			// first response
+			total = int(*versions.ResultCount)
+		}
+
+		cursor = versions.Cursor
+		if cursor == nil || len(results) >= total {
+			return results, nil
+		}
+	}
+}
+
+func setPolicyIntrusionServiceActiveSignatureVersion(connector *client.RestConnector, versionID string) error {
+	versions, err := listPolicyIntrusionServiceSignatureVersions(connector)
+	if err != nil {
+		return err
+	}
+
+	for _, version := range versions {
+		if version.VersionId == nil || *version.VersionId != versionID {
+			continue
+		}
+		if version.State != nil && *version.State == model.IdsSignatureVersion_STATE_ACTIVE {
+			return nil
+		}
+
+		log.Printf("[INFO] Activating IDS signature version %s", versionID)
+		client := intrusion_services.NewSignatureVersionsClient(connector)
+		return client.Makeactiveversion(model.IdsSignatureVersion{Id: version.Id})
+	}
+
+	return fmt.Errorf("IDS signature version %s was not found", versionID)
+}
+
+func policyIntrusionServiceSettingsPatch(connector *client.RestConnector, autoUpdate bool, eventsToSyslog bool, standaloneEnabled bool) error {
+	settingsClient := security.NewIntrusionServicesClient(connector)
+	err := settingsClient.Patch(model.IdsSettings{
+		AutoUpdate:        &autoUpdate,
+		IdsEventsToSyslog: &eventsToSyslog,
+	})
+	if err != nil {
+		return err
+	}
+
+	hostClient := intrusion_services.NewIdsStandaloneHostConfigClient(connector)
+	return hostClient.Patch(model.IdsStandaloneHostConfig{
+		IdsEnabled: &standaloneEnabled,
+	})
+}
+
+func resourceNsxtPolicyIntrusionServiceSettingsApply(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	err := policyIntrusionServiceSettingsPatch(connector,
+		d.Get("auto_update_signatures").(bool),
+		d.Get("ids_events_to_syslog").(bool),
+		d.Get("standalone_hosts_enabled").(bool))
+	if err != nil {
+		return err
+	}
+
+	// Only activate signature version if it was explicitly configured
+	if d.HasChange("signature_version") {
+		versionID := d.Get("signature_version").(string)
+		if versionID != "" {
+			return setPolicyIntrusionServiceActiveSignatureVersion(connector, versionID)
+		}
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyIntrusionServiceSettingsCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return resourceNotSupportedError()
+	}
+
+	id := newUUID()
+	log.Printf("[INFO] Creating Intrusion Service Settings")
+	err := resourceNsxtPolicyIntrusionServiceSettingsApply(d, m)
+	if err != nil {
+		return handleCreateError("Intrusion Service Settings", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceNsxtPolicyIntrusionServiceSettingsRead(d, m)
+}
+
+func resourceNsxtPolicyIntrusionServiceSettingsRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+
+	settingsClient := security.NewIntrusionServicesClient(connector)
+	obj, err := settingsClient.Get()
+	if err != nil {
+		return handleReadError(d, "Intrusion Service Settings", id, err)
+	}
+
+	d.Set("revision", obj.Revision)
+	d.Set("auto_update_signatures", obj.AutoUpdate)
+	d.Set("ids_events_to_syslog", obj.IdsEventsToSyslog)
+
+	hostClient := intrusion_services.NewIdsStandaloneHostConfigClient(connector)
+	hostConfig, err := hostClient.Get()
+	if err != nil {
+		return handleReadError(d, "Intrusion Service Standalone Host Config", id, err)
+	}
+	d.Set("standalone_hosts_enabled", hostConfig.IdsEnabled)
+
+	versions, err := listPolicyIntrusionServiceSignatureVersions(connector)
+	if err != nil {
+		return handleReadError(d, "Intrusion Service Signature Versions", id, err)
+	}
+	for _, version := range versions {
+		if version.State != nil && *version.State == model.IdsSignatureVersion_STATE_ACTIVE {
+			d.Set("signature_version", version.VersionId)
+			break
+		}
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyIntrusionServiceSettingsUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+
+	log.Printf("[INFO] Updating Intrusion Service Settings")
+	err := resourceNsxtPolicyIntrusionServiceSettingsApply(d, m)
+	if err != nil {
+		return handleUpdateError("Intrusion Service Settings", id, err)
+	}
+
+	return resourceNsxtPolicyIntrusionServiceSettingsRead(d, m)
+}
+
+func resourceNsxtPolicyIntrusionServiceSettingsDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+
+	// There is no DELETE API for these objects - revert to defaults.
+	// Active signature version is left as is.
+	err := policyIntrusionServiceSettingsPatch(getPolicyConnector(m), true, false, false)
+	if err != nil {
+		return handleDeleteError("Intrusion Service Settings", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceNsxtPolicyIntrusionServiceSettings_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_intrusion_service_settings.test"
+
+	// Settings are global, hence this test should not run in parallel
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "3.1.0")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIntrusionServiceSettingsTemplate(false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "auto_update_signatures", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "ids_events_to_syslog", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "standalone_hosts_enabled", "false"),
+					resource.TestCheckResourceAttrSet(testResourceName, "signature_version"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyIntrusionServiceSettingsTemplate(true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "auto_update_signatures", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "ids_events_to_syslog", "false"),
+					resource.TestCheckResourceAttrSet(testResourceName, "signature_version"),
+				),
+			},
+			{
+				Config:      testAccNsxtPolicyIntrusionServiceSettingsPinnedVersionTemplate(true),
+				ExpectError: regexp.MustCompile(`signature_version can only be pinned with auto_update_signatures set to false`),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIntrusionServiceSettingsTemplate(autoUpdate bool, toSyslog bool) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_intrusion_service_settings" "test" {
+  auto_update_signatures = %t
+  ids_events_to_syslog   = %t
+}`, autoUpdate, toSyslog)
+}
+
+func testAccNsxtPolicyIntrusionServiceSettingsPinnedVersionTemplate(autoUpdate bool) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_intrusion_service_settings" "test" {
+  auto_update_signatures = %t
+  signature_version      = "3.1.1-2022.03.15"
+}`, autoUpdate)
+}
@@ -0,0 +1,328 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var l7AccessActionValues = []string{
+	model.L7AccessEntry_ACTION_ALLOW,
+	model.L7AccessEntry_ACTION_REJECT,
+	model.L7AccessEntry_ACTION_REJECT_WITH_RESPONSE,
+}
+
+var l7AccessAttributeKeyValues = []string{
+	model.L7AccessAttributes_KEY_APP_ID,
+	model.L7AccessAttributes_KEY_URL_CATEGORY,
+	model.L7AccessAttributes_KEY_CUSTOM_URL,
+}
+
+func resourceNsxtPolicyL7AccessProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyL7AccessProfileCreate,
+		Read:   resourceNsxtPolicyL7AccessProfileRead,
+		Update: resourceNsxtPolicyL7AccessProfileUpdate,
+		Delete: resourceNsxtPolicyL7AccessProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"default_action": {
+				Type:         schema.TypeString,
+				Description:  "Action applied to traffic that does not match any entry",
+				Optional:     true,
+				Default:      model.L7AccessProfile_DEFAULT_ACTION_ALLOW,
+				ValidateFunc: validation.StringInSlice(l7AccessActionValues, false),
+			},
+			"default_action_logged": {
+				Type:        schema.TypeBool,
+				Description: "Flag to enable packet logging for default action",
+				Optional:    true,
+				Default:     false,
+			},
+			"entry": {
+				Type:        schema.TypeList,
+				Description: "Ordered list of L7 access entries",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"nsx_id": getFlexNsxIDSchema(),
+						"display_name": {
+							Type:        schema.TypeString,
+							Description: "Display name of this entry",
+							Optional:    true,
+							Computed:    true,
+						},
+						"description": getDescriptionSchema(),
+						"sequence_number": {
+							Type:        schema.TypeInt,
+							Description: "Sequence number of this entry",
+							Computed:    true,
+						},
+						"action": {
+							Type:         schema.TypeString,
+							Description:  "Action applied to traffic matching this entry",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(l7AccessActionValues, false),
+						},
+						"disabled": {
+							Type:        schema.TypeBool,
+							Description: "Flag to disable this entry",
+							Optional:    true,
+							Default:     false,
+						},
+						"logged": {
+							Type:        schema.TypeBool,
+							Description: "Flag to enable packet logging",
+							Optional:    true,
+							Default:     false,
+						},
+						"attribute": {
+							Type:        schema.TypeList,
+							Description: "Attribute matched by this entry",
+							Required:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:         schema.TypeString,
+										Description:  "Attribute key",
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(l7AccessAttributeKeyValues, false),
+									},
+									"values": {
+										Type:        schema.TypeSet,
+										Description: "Attribute values",
+										Required:    true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyL7AccessProfileExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	client := infra.NewL7AccessProfilesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getPolicyL7AccessEntriesFromSchema(d *schema.ResourceData) []model.L7AccessEntry {
+	var entries []model.L7AccessEntry
+	for i, item := range d.Get("entry").([]interface{}) {
+		data := item.(map[string]interface{})
+		id := newUUID()
+		if nsxID := data["nsx_id"].(string); nsxID != "" {
+			id = nsxID
+		}
+		displayName := data["display_name"].(string)
+		if displayName == "" {
+			displayName = id
+		}
+		description := data["description"].(string)
+		action := data["action"].(string)
+		disabled := data["disabled"].(bool)
+		logged := data["logged"].(bool)
+		sequenceNumber := int64(i)
+
+		var attributes []model.L7AccessAttributes
+		for _, attr := range data["attribute"].([]interface{}) {
+			attrData := attr.(map[string]interface{})
+			key := attrData["key"].(string)
+			source := model.L7AccessAttributes_ATTRIBUTE_SOURCE_SYSTEM
+			if key == model.L7AccessAttributes_KEY_CUSTOM_URL {
+				source = model.L7AccessAttributes_ATTRIBUTE_SOURCE_CUSTOM
+			}
+			datatype := model.L7AccessAttributes_DATATYPE_STRING
+			attributes = append(attributes, model.L7AccessAttributes{
+				Key:             &key,
+				AttributeSource: &source,
+				Datatype:        &datatype,
+				Value:           interface2StringList(attrData["values"].(*schema.Set).List()),
+			})
+		}
+
+		entries = append(entries, model.L7AccessEntry{
+			Id:             &id,
+			DisplayName:    &displayName,
+			Description:    &description,
+			Action:         &action,
+			Disabled:       &disabled,
+			Logged:         &logged,
+			SequenceNumber: &sequenceNumber,
+			Attributes:     attributes,
+		})
+	}
+
+	return entries
+}
+
+func setPolicyL7AccessEntriesInSchema(d *schema.ResourceData, entries []model.L7AccessEntry) error {
+	var entryList []map[string]interface{}
+	for _, entry := range entries {
+		elem := make(map[string]interface{})
+		elem["nsx_id"] = entry.Id
+		elem["display_name"] = entry.DisplayName
+		elem["description"] = entry.Description
+		elem["sequence_number"] = entry.SequenceNumber
+		elem["action"] = entry.Action
+		elem["disabled"] = entry.Disabled
+		elem["logged"] = entry.Logged
+
+		var attrList []map[string]interface{}
+		for _, attr := range entry.Attributes {
+			attrElem := make(map[string]interface{})
+			attrElem["key"] = attr.Key
+			attrElem["values"] = attr.Value
+			attrList = append(attrList, attrElem)
+		}
+		elem["attribute"] = attrList
+
+		entryList = append(entryList, elem)
+	}
+
+	return d.Set("entry", entryList)
+}
+
+func getPolicyL7AccessProfileFromSchema(d *schema.ResourceData) model.L7AccessProfile {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	defaultAction := d.Get("default_action").(string)
+	defaultActionLogged := d.Get("default_action_logged").(bool)
+
+	return model.L7AccessProfile{
+		DisplayName:         &displayName,
+		Description:         &description,
+		Tags:                tags,
+		DefaultAction:       &defaultAction,
+		DefaultActionLogged: &defaultActionLogged,
+		L7AccessEntries:     getPolicyL7AccessEntriesFromSchema(d),
+	}
+}
+
+func resourceNsxtPolicyL7AccessProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return resourceNotSupportedError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyL7AccessProfileExists)
+	if err != nil {
+		return err
+	}
+
+	obj := getPolicyL7AccessProfileFromSchema(d)
+
+	// Create the resource using PATCH
+	log.Printf("[INFO] Creating L7 Access Profile with ID %s", id)
+	client := infra.NewL7AccessProfilesClient(connector)
+	_, err = client.Patch(id, obj, nil)
+	if err != nil {
+		return handleCreateError("L7 Access Profile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyL7AccessProfileRead(d, m)
+}
+
+func resourceNsxtPolicyL7AccessProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining L7 Access Profile ID")
+	}
+
+	client := infra.NewL7AccessProfilesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "L7 Access Profile", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("default_action", obj.DefaultAction)
+	d.Set("default_action_logged", obj.DefaultActionLogged)
+
+	return setPolicyL7AccessEntriesInSchema(d, obj.L7AccessEntries)
+}
+
+func resourceNsxtPolicyL7AccessProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining L7 Access Profile ID")
+	}
+
+	obj := getPolicyL7AccessProfileFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+
+	// Update the resource using PUT, so that entries removed from configuration
+	// are deleted on NSX
+	client := infra.NewL7AccessProfilesClient(connector)
+	_, err := client.Update(id, obj, nil)
+	if err != nil {
+		return handleUpdateError("L7 Access Profile", id, err)
+	}
+
+	return resourceNsxtPolicyL7AccessProfileRead(d, m)
+}
+
+func resourceNsxtPolicyL7AccessProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining L7 Access Profile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewL7AccessProfilesClient(connector)
+	err := client.Delete(id, nil)
+	if err != nil {
+		return handleDeleteError("L7 Access Profile", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyL7AccessProfile_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_l7_access_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_l7_access_profile", resourceNsxtPolicyL7AccessProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyL7AccessProfileCreateTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyL7AccessProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "default_action", "ALLOW"),
+					resource.TestCheckResourceAttr(testResourceName, "default_action_logged", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "entry.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "entry.0.action", "REJECT"),
+					resource.TestCheckResourceAttr(testResourceName, "entry.0.sequence_number", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "entry.0.attribute.0.key", "URL_CATEGORY"),
+					resource.TestCheckResourceAttr(testResourceName, "entry.0.attribute.0.values.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "entry.1.action", "REJECT_WITH_RESPONSE"),
+					resource.TestCheckResourceAttr(testResourceName, "entry.1.logged", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "entry.1.sequence_number", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "entry.1.attribute.0.key", "CUSTOM_URL"),
+					resource.TestCheckResourceAttrSet(testResourceName, "entry.0.nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyL7AccessProfileUpdateTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyL7AccessProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "default_action", "REJECT"),
+					resource.TestCheckResourceAttr(testResourceName, "default_action_logged", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "entry.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "entry.0.action", "ALLOW"),
+					resource.TestCheckResourceAttr(testResourceName, "entry.0.attribute.0.key", "APP_ID"),
+					resource.TestCheckResourceAttr(testResourceName, "entry.0.attribute.0.values.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyL7AccessProfile_withGatewayPolicy(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_gateway_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_l7_access_profile", resourceNsxtPolicyL7AccessProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyL7AccessProfileWithGatewayPolicyTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists("nsxt_policy_l7_access_profile.test", resourceNsxtPolicyL7AccessProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.profiles.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyL7AccessProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_l7_access_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_l7_access_profile", resourceNsxtPolicyL7AccessProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyL7AccessProfileCreateTemplate(name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyL7AccessProfileCreateTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_l7_access_profile" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+
+  entry {
+    action = "REJECT"
+    attribute {
+      key    = "URL_CATEGORY"
+      values = ["Abortion", "Gambling"]
+    }
+  }
+
+  entry {
+    display_name = "custom"
+    action       = "REJECT_WITH_RESPONSE"
+    logged       = true
+    attribute {
+      key    = "CUSTOM_URL"
+      values = ["*.example.com"]
+    }
+  }
+
+  tag {
+    scope = "color"
+    tag   = "orange"
+  }
+}`, name)
+}
+
+func testAccNsxtPolicyL7AccessProfileUpdateTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_l7_access_profile" "test" {
+  display_name          = "%s"
+  description           = "Acceptance Test"
+  default_action        = "REJECT"
+  default_action_logged = true
+
+  entry {
+    action = "ALLOW"
+    attribute {
+      key    = "APP_ID"
+      values = ["SSL"]
+    }
+  }
+}`, name)
+}
+
+func testAccNsxtPolicyL7AccessProfileWithGatewayPolicyTemplate(name string) string {
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) +
+		testAccNsxtPolicyTier1WithEdgeClusterTemplate("test", false) +
+		testAccNsxtPolicyL7AccessProfileCreateTemplate(name) + fmt.Sprintf(`
+
+resource "nsxt_policy_gateway_policy" "test" {
+  display_name = "%s"
+  category     = "LocalGatewayRules"
+
+  rule {
+    display_name = "url-filtering"
+    action       = "ALLOW"
+    profiles     = [nsxt_policy_l7_access_profile.test.path]
+    scope        = [nsxt_policy_tier1_gateway.test.path]
+  }
+}`, name)
+}
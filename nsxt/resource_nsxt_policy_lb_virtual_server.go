@@ -791,8 +791,10 @@ func getPolicyLbRuleJwtAuthActionSchema() *schema.Schema {
 								Optional: true,
 							},
 							"symmetric_key": {
-								Type:     schema.TypeString,
-								Optional: true,
+								Type:        schema.TypeString,
+								Optional:    true,
+								Sensitive:   true,
+								Description: "Symmetric key, not returned by NSX",
 							},
 						},
 					},
@@ -1045,7 +1047,7 @@ func setPolicyLbRulesInSchema(d *schema.ResourceData, rules []model.LBRule) {
 	converter.SetMode(bindings.REST)
 
 	var ruleList []interface{}
-	for _, rule := range rules {
+	for ruleNo, rule := range rules {
 		ruleElem := make(map[string]interface{})
 		if rule.DisplayName != nil {
 			ruleElem["display_name"] = *rule.DisplayName
@@ -1156,7 +1158,8 @@ func setPolicyLbRulesInSchema(d *schema.ResourceData, rules []model.LBRule) {
 					specificKeyType, _ := converter.ConvertToGolang(key, model.LBJwtCertificateKeyBindingType())
 					keyElem["certificate_path"] = specificKeyType.(model.LBJwtCertificateKey).CertificatePath
 				} else if keyType == model.LBJwtKey_TYPE_LBJWTSYMMETRICKEY {
-					keyElem["symmetric_key"] = "dummy"
+					// NSX does not return the key, hence value known to Terraform is kept
+					keyElem["symmetric_key"] = getPolicyLbRuleJwtSymmetricKey(d, ruleNo, len(jwtAuthActionList))
 				} else if keyType == model.LBJwtKey_TYPE_LBJWTPUBLICKEY {
 					specificKeyType, _ := converter.ConvertToGolang(key, model.LBJwtPublicKeyBindingType())
 					keyElem["public_key_content"] = specificKeyType.(model.LBJwtPublicKey).PublicKeyContent
@@ -1388,6 +1391,30 @@ func getRuleActionOrMethod(ruleData map[string]interface{}, key string, stringFi
 	return result
 }
 
+// Get symmetric key of JWT auth action from current state, if any
+func getPolicyLbRuleJwtSymmetricKey(d *schema.ResourceData, ruleNo int, actionNo int) string {
+	rules := d.Get("rule").([]interface{})
+	if ruleNo >= len(rules) || rules[ruleNo] == nil {
+		return ""
+	}
+	for _, ruleAction := range rules[ruleNo].(map[string]interface{})["action"].([]interface{}) {
+		if ruleAction == nil {
+			continue
+		}
+		jwtActions := ruleAction.(map[string]interface{})["jwt_auth"].([]interface{})
+		if actionNo >= len(jwtActions) || jwtActions[actionNo] == nil {
+			continue
+		}
+		for _, key := range jwtActions[actionNo].(map[string]interface{})["key"].(*schema.Set).List() {
+			if symmetricKey, ok := key.(map[string]interface{})["symmetric_key"].(string); ok && symmetricKey != "" {
+				return symmetricKey
+			}
+		}
+	}
+
+	return ""
+}
+
 func getPolicyLbRulesFromSchema(d *schema.ResourceData) []model.LBRule {
 	var ruleList []model.LBRule
 	rules := d.Get("rule").([]interface{})
@@ -631,6 +631,38 @@ func TestAccResourceNsxtPolicyLBVirtualServer_withRules(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtPolicyLBVirtualServer_withJwtSymmetricKey(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_virtual_server.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccOnlyLocalManager(t)
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBVirtualServerCheckDestroy(state, accTestPolicyLBVirtualServerCreateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				// Symmetric key is not returned by NSX, and should not produce a diff
+				Config: testAccNsxtPolicyLBVirtualServerWithJwtSymmetricKey(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBVirtualServerExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.action.0.jwt_auth.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.action.0.jwt_auth.0.key.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.action.0.jwt_auth.0.key.0.symmetric_key", "secret"),
+				),
+			},
+			{
+				Config:   testAccNsxtPolicyLBVirtualServerWithJwtSymmetricKey(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccResourceNsxtPolicyLBVirtualServer_importBasic(t *testing.T) {
 	name := getAccTestResourceName()
 	testResourceName := "nsxt_policy_lb_virtual_server.test"
@@ -1142,3 +1174,34 @@ data "nsxt_policy_realization_info" "realization_info" {
   path = nsxt_policy_lb_virtual_server.test.path
 }`, attrMap["display_name"], attrMap["ip_address"])
 }
+
+func testAccNsxtPolicyLBVirtualServerWithJwtSymmetricKey() string {
+	attrMap := accTestPolicyLBVirtualServerCreateAttributes
+	return fmt.Sprintf(`
+data "nsxt_policy_lb_app_profile" "default_http"{
+    type = "HTTP"
+}
+
+resource "nsxt_policy_lb_virtual_server" "test" {
+  display_name             = "%s"
+  application_profile_path = data.nsxt_policy_lb_app_profile.default_http.path
+  ip_address               = "%s"
+  ports                    = ["%s"]
+
+  rule {
+    display_name = "jwt_auth_test"
+    phase        = "HTTP_ACCESS"
+
+    action {
+      jwt_auth {
+        key {
+          symmetric_key = "secret"
+        }
+        realm  = "realm"
+        tokens = ["a"]
+      }
+    }
+  }
+}
+`, attrMap["display_name"], attrMap["ip_address"], attrMap["ports"])
+}
@@ -0,0 +1,299 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var redirectionRuleActionValues = []string{
+	model.RedirectionRule_ACTION_REDIRECT,
+	model.RedirectionRule_ACTION_DO_NOT_REDIRECT,
+}
+
+func getRedirectionRulesSchema() *schema.Schema {
+	ruleSchema := getSecurityPolicyAndGatewayRulesSchema(false, false)
+	elemSchema := ruleSchema.Elem.(*schema.Resource).Schema
+
+	elemSchema["action"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Action",
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(redirectionRuleActionValues, false),
+		Default:      model.RedirectionRule_ACTION_REDIRECT,
+	}
+	ruleSchema.Description = "List of redirection rules"
+
+	return ruleSchema
+}
+
+func resourceNsxtPolicyRedirectionPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyRedirectionPolicyCreate,
+		Read:   resourceNsxtPolicyRedirectionPolicyRead,
+		Update: resourceNsxtPolicyRedirectionPolicyUpdate,
+		Delete: resourceNsxtPolicyRedirectionPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: nsxtDomainResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"domain":       getDomainNameSchema(),
+			"redirect_to": {
+				Type:        schema.TypeList,
+				Description: "Path of service chain or redirection target",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validatePolicyPath(),
+				},
+			},
+			"comments": {
+				Type:        schema.TypeString,
+				Description: "Comments for redirection policy lock/unlock",
+				Optional:    true,
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Description: "Indicates whether a redirection policy should be locked. If locked by a user, no other user would be able to modify this policy",
+				Optional:    true,
+				Default:     false,
+			},
+			"sequence_number": {
+				Type:        schema.TypeInt,
+				Description: "This field is used to resolve conflicts between redirection policies across domains",
+				Optional:    true,
+				Default:     0,
+			},
+			"north_south": {
+				Type:        schema.TypeBool,
+				Description: "Indicates whether this policy redirects north-south traffic",
+				Computed:    true,
+			},
+			"rule": getRedirectionRulesSchema(),
+		},
+	}
+}
+
+func resourceNsxtPolicyRedirectionPolicyExistsInDomain(id string, domainName string, connector *client.RestConnector) (bool, error) {
+	client := domains.NewRedirectionPoliciesClient(connector)
+	_, err := client.Get(domainName, id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving Redirection Policy", err)
+}
+
+func resourceNsxtPolicyRedirectionPolicyExistsPartial(domainName string) func(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	return func(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+		return resourceNsxtPolicyRedirectionPolicyExistsInDomain(id, domainName, connector)
+	}
+}
+
+func getPolicyRedirectionRulesFromSchema(d *schema.ResourceData) []model.RedirectionRule {
+	var ruleList []model.RedirectionRule
+	for seq, item := range d.Get("rule").([]interface{}) {
+		// Redirection rules share all attributes with firewall rules but action
+		rule := getPolicyRuleFromElem(item.(map[string]interface{}), int64(seq))
+		resourceType := "RedirectionRule"
+		ruleList = append(ruleList, model.RedirectionRule{
+			ResourceType:         &resourceType,
+			Id:                   rule.Id,
+			DisplayName:          rule.DisplayName,
+			Description:          rule.Description,
+			Notes:                rule.Notes,
+			Logged:               rule.Logged,
+			Tag:                  rule.Tag,
+			Tags:                 rule.Tags,
+			Action:               rule.Action,
+			Disabled:             rule.Disabled,
+			SourcesExcluded:      rule.SourcesExcluded,
+			DestinationsExcluded: rule.DestinationsExcluded,
+			IpProtocol:           rule.IpProtocol,
+			Direction:            rule.Direction,
+			SourceGroups:         rule.SourceGroups,
+			DestinationGroups:    rule.DestinationGroups,
+			Services:             rule.Services,
+			Scope:                rule.Scope,
+			Profiles:             rule.Profiles,
+			SequenceNumber:       rule.SequenceNumber,
+		})
+	}
+
+	return ruleList
+}
+
+func setPolicyRedirectionRulesInSchema(d *schema.ResourceData, rules []model.RedirectionRule) error {
+	var rulesList []map[string]interface{}
+	for _, rule := range rules {
+		elem := getPolicyRuleElemFromModel(model.Rule{
+			Id:                   rule.Id,
+			DisplayName:          rule.DisplayName,
+			Description:          rule.Description,
+			Revision:             rule.Revision,
+			Notes:                rule.Notes,
+			Logged:               rule.Logged,
+			Tag:                  rule.Tag,
+			Tags:                 rule.Tags,
+			Action:               rule.Action,
+			Disabled:             rule.Disabled,
+			SourcesExcluded:      rule.SourcesExcluded,
+			DestinationsExcluded: rule.DestinationsExcluded,
+			IpProtocol:           rule.IpProtocol,
+			Direction:            rule.Direction,
+			SourceGroups:         rule.SourceGroups,
+			DestinationGroups:    rule.DestinationGroups,
+			Services:             rule.Services,
+			Scope:                rule.Scope,
+			Profiles:             rule.Profiles,
+			SequenceNumber:       rule.SequenceNumber,
+			RuleId:               rule.RuleId,
+		})
+		rulesList = append(rulesList, elem)
+	}
+
+	return d.Set("rule", rulesList)
+}
+
+func getPolicyRedirectionPolicyFromSchema(d *schema.ResourceData) model.RedirectionPolicy {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	comments := d.Get("comments").(string)
+	locked := d.Get("locked").(bool)
+	sequenceNumber := int64(d.Get("sequence_number").(int))
+
+	return model.RedirectionPolicy{
+		DisplayName:    &displayName,
+		Description:    &description,
+		Tags:           tags,
+		Comments:       &comments,
+		Locked:         &locked,
+		SequenceNumber: &sequenceNumber,
+		RedirectTo:     interface2StringList(d.Get("redirect_to").([]interface{})),
+		Rules:          getPolicyRedirectionRulesFromSchema(d),
+	}
+}
+
+func resourceNsxtPolicyRedirectionPolicyCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return resourceNotSupportedError()
+	}
+
+	connector := getPolicyConnector(m)
+	domain := d.Get("domain").(string)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyRedirectionPolicyExistsPartial(domain))
+	if err != nil {
+		return err
+	}
+
+	obj := getPolicyRedirectionPolicyFromSchema(d)
+
+	// Create the resource using PATCH
+	log.Printf("[INFO] Creating Redirection Policy with ID %s", id)
+	client := domains.NewRedirectionPoliciesClient(connector)
+	err = client.Patch(domain, id, obj)
+	if err != nil {
+		return handleCreateError("Redirection Policy", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyRedirectionPolicyRead(d, m)
+}
+
+func resourceNsxtPolicyRedirectionPolicyRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	domain := d.Get("domain").(string)
+	if id == "" {
+		return fmt.Errorf("Error obtaining Redirection Policy ID")
+	}
+
+	client := domains.NewRedirectionPoliciesClient(connector)
+	obj, err := client.Get(domain, id)
+	if err != nil {
+		return handleReadError(d, "Redirection Policy", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("domain", getDomainFromResourcePath(*obj.Path))
+
+	d.Set("redirect_to", obj.RedirectTo)
+	d.Set("comments", obj.Comments)
+	d.Set("locked", obj.Locked)
+	d.Set("sequence_number", obj.SequenceNumber)
+	d.Set("north_south", obj.NorthSouth)
+
+	return setPolicyRedirectionRulesInSchema(d, obj.Rules)
+}
+
+func resourceNsxtPolicyRedirectionPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	domain := d.Get("domain").(string)
+	if id == "" {
+		return fmt.Errorf("Error obtaining Redirection Policy ID")
+	}
+
+	obj := getPolicyRedirectionPolicyFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+
+	// Update the resource using PUT, so that rules removed from configuration
+	// are deleted on NSX
+	client := domains.NewRedirectionPoliciesClient(connector)
+	_, err := client.Update(domain, id, obj)
+	if err != nil {
+		return handleUpdateError("Redirection Policy", id, err)
+	}
+
+	return resourceNsxtPolicyRedirectionPolicyRead(d, m)
+}
+
+func resourceNsxtPolicyRedirectionPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	domain := d.Get("domain").(string)
+	if id == "" {
+		return fmt.Errorf("Error obtaining Redirection Policy ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := domains.NewRedirectionPoliciesClient(connector)
+	err := client.Delete(domain, id)
+	if err != nil {
+		return handleDeleteError("Redirection Policy", id, err)
+	}
+
+	return nil
+}
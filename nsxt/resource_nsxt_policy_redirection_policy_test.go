@@ -0,0 +1,114 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccNsxtPolicyRedirectionPolicyExists(resourceName string) resource.TestCheckFunc {
+	return testAccNsxtPolicyResourceExists(resourceName, resourceNsxtPolicyRedirectionPolicyExistsPartial(defaultDomain))
+}
+
+func TestAccResourceNsxtPolicyRedirectionPolicy_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_redirection_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccNsxtPolicyServiceChainPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_redirection_policy", resourceNsxtPolicyRedirectionPolicyExistsPartial(defaultDomain))
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyRedirectionPolicyTemplate(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyRedirectionPolicyExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "domain", defaultDomain),
+					resource.TestCheckResourceAttrPair(testResourceName, "redirect_to.0", "nsxt_policy_service_chain.test", "path"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", "rule1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.action", "REDIRECT"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.display_name", "rule2"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.action", "DO_NOT_REDIRECT"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyRedirectionPolicyTemplate(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyRedirectionPolicyExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", "rule1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyRedirectionPolicy_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_redirection_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccNsxtPolicyServiceChainPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_redirection_policy", resourceNsxtPolicyRedirectionPolicyExistsPartial(defaultDomain))
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyRedirectionPolicyTemplate(name, true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyRedirectionPolicyTemplate(name string, withSecondRule bool) string {
+	secondRule := ""
+	if withSecondRule {
+		secondRule = `
+  rule {
+    display_name = "rule2"
+    action       = "DO_NOT_REDIRECT"
+    logged       = true
+  }`
+	}
+
+	return testAccNsxtPolicyServiceChainTemplate("chain-"+name, "ALLOW", false) + fmt.Sprintf(`
+
+resource "nsxt_policy_group" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_redirection_policy" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+  redirect_to  = [nsxt_policy_service_chain.test.path]
+
+  rule {
+    display_name  = "rule1"
+    source_groups = [nsxt_policy_group.test.path]
+    action        = "REDIRECT"
+  }
+%s
+}`, name, name, secondRule)
+}
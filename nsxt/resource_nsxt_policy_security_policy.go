@@ -17,12 +17,13 @@ import (
 
 func resourceNsxtPolicySecurityPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicySecurityPolicyCreate,
-		Read:   resourceNsxtPolicySecurityPolicyRead,
-		Update: resourceNsxtPolicySecurityPolicyUpdate,
-		Delete: resourceNsxtPolicySecurityPolicyDelete,
+		Create:        resourceNsxtPolicySecurityPolicyCreate,
+		Read:          resourceNsxtPolicySecurityPolicyRead,
+		Update:        resourceNsxtPolicySecurityPolicyUpdate,
+		Delete:        resourceNsxtPolicySecurityPolicyDelete,
+		CustomizeDiff: policyRulesAnalysisCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			State: nsxtDomainResourceImporter,
+			State: nsxtDomainResourcePromotedImporter("security-policies"),
 		},
 		Schema: getPolicySecurityPolicySchema(false),
 	}
@@ -110,6 +111,15 @@ func policySecurityPolicyBuildAndPatch(d *schema.ResourceData, m interface{}, co
 		obj.Revision = &revision
 	}
 
+	schedulePath := d.Get("schedule_path").(string)
+	if schedulePath != "" && isGlobalManager {
+		return fmt.Errorf("schedule_path setting is not supported with NSX Global Manager")
+	}
+	if schedulePath != "" || d.HasChange("schedule_path") {
+		// Empty value is sent explicitly in order to detach the schedule
+		obj.SchedulerPath = &schedulePath
+	}
+
 	policyChildren, err := getUpdatedRuleChildren(d)
 	if err != nil {
 		return err
@@ -185,6 +195,7 @@ func resourceNsxtPolicySecurityPolicyRead(d *schema.ResourceData, m interface{})
 	}
 	d.Set("sequence_number", obj.SequenceNumber)
 	d.Set("stateful", obj.Stateful)
+	d.Set("schedule_path", obj.SchedulerPath)
 	d.Set("tcp_strict", obj.TcpStrict)
 	d.Set("revision", obj.Revision)
 	return setPolicyRulesInSchema(d, obj.Rules)
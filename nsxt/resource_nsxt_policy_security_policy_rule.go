@@ -0,0 +1,292 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_gateway_policies "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/domains/gateway_policies"
+	gm_security_policies "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/domains/security_policies"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains/gateway_policies"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains/security_policies"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicySecurityPolicyRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicySecurityPolicyRuleCreate,
+		Read:   resourceNsxtPolicySecurityPolicyRuleRead,
+		Update: resourceNsxtPolicySecurityPolicyRuleUpdate,
+		Delete: resourceNsxtPolicySecurityPolicyRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicySecurityPolicyRuleImport,
+		},
+
+		Schema: getPolicySecurityPolicyRuleSchema(),
+	}
+}
+
+func getPolicySecurityPolicyRuleSchema() map[string]*schema.Schema {
+	ruleSchema := getSecurityPolicyAndGatewayRulesSchema(false, false).Elem.(*schema.Resource).Schema
+	ruleSchema["nsx_id"] = getNsxIDSchema()
+	ruleSchema["path"] = getPathSchema()
+	ruleSchema["policy_path"] = getPolicyPathSchema(true, true, "Path of security or gateway policy this rule belongs to")
+	ruleSchema["sequence_number"].Description = "Sequence number of this rule within the policy. If not specified, the rule is placed after existing rules"
+
+	return ruleSchema
+}
+
+// Parse security policy or gateway policy path into domain, policy ID and
+// indication whether this is a gateway policy
+func parsePolicyPathForRule(policyPath string) (string, string, bool, error) {
+	domain := getDomainFromResourcePath(policyPath)
+	if domain == "" {
+		return "", "", false, fmt.Errorf("Failed to parse domain from policy path %s", policyPath)
+	}
+
+	if policyID := getResourceIDFromResourcePath(policyPath, "security-policies"); policyID != "" {
+		return domain, policyID, false, nil
+	}
+	if policyID := getResourceIDFromResourcePath(policyPath, "gateway-policies"); policyID != "" {
+		return domain, policyID, true, nil
+	}
+
+	return "", "", false, fmt.Errorf("Expected security policy or gateway policy path, got %s", policyPath)
+}
+
+func getPolicySecurityPolicyRule(connector *client.RestConnector, policyPath string, id string, isGlobalManager bool) (model.Rule, error) {
+	domain, policyID, isGatewayPolicy, err := parsePolicyPathForRule(policyPath)
+	if err != nil {
+		return model.Rule{}, err
+	}
+
+	if isGlobalManager {
+		var gmObj gm_model.Rule
+		if isGatewayPolicy {
+			gmObj, err = gm_gateway_policies.NewRulesClient(connector).Get(domain, policyID, id)
+		} else {
+			gmObj, err = gm_security_policies.NewRulesClient(connector).Get(domain, policyID, id)
+		}
+		if err != nil {
+			return model.Rule{}, err
+		}
+		rawObj, convErr := convertModelBindingType(gmObj, gm_model.RuleBindingType(), model.RuleBindingType())
+		if convErr != nil {
+			return model.Rule{}, convErr
+		}
+		return rawObj.(model.Rule), nil
+	}
+
+	if isGatewayPolicy {
+		return gateway_policies.NewRulesClient(connector).Get(domain, policyID, id)
+	}
+	return security_policies.NewRulesClient(connector).Get(domain, policyID, id)
+}
+
+func resourceNsxtPolicySecurityPolicyRuleExistsPartial(policyPath string) func(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	return func(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+		_, err := getPolicySecurityPolicyRule(connector, policyPath, id, isGlobalManager)
+		if err == nil {
+			return true, nil
+		}
+
+		if isNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, logAPIError("Error retrieving Security Policy Rule", err)
+	}
+}
+
+// Get sequence number that places a new rule after all existing rules in the policy
+func getPolicyNextRuleSequenceNumber(connector *client.RestConnector, policyPath string, isGlobalManager bool) (int64, error) {
+	domain, policyID, isGatewayPolicy, err := parsePolicyPathForRule(policyPath)
+	if err != nil {
+		return 0, err
+	}
+
+	var rules []model.Rule
+	if isGatewayPolicy {
+		policy, err := getGatewayPolicyInDomain(policyID, domain, connector, isGlobalManager)
+		if err != nil {
+			return 0, err
+		}
+		rules = policy.Rules
+	} else {
+		policy, err := getSecurityPolicyInDomain(policyID, domain, connector, isGlobalManager)
+		if err != nil {
+			return 0, err
+		}
+		rules = policy.Rules
+	}
+
+	var sequenceNumber int64
+	for _, rule := range rules {
+		if rule.SequenceNumber != nil && *rule.SequenceNumber >= sequenceNumber {
+			sequenceNumber = *rule.SequenceNumber + 1
+		}
+	}
+
+	return sequenceNumber, nil
+}
+
+func policySecurityPolicyRulePatch(d *schema.ResourceData, m interface{}, id string, sequenceNumber int64) error {
+	connector := getPolicyConnector(m)
+	policyPath := d.Get("policy_path").(string)
+	domain, policyID, isGatewayPolicy, err := parsePolicyPathForRule(policyPath)
+	if err != nil {
+		return err
+	}
+
+	data := make(map[string]interface{})
+	for key := range getPolicySecurityPolicyRuleSchema() {
+		data[key] = d.Get(key)
+	}
+	data["nsx_id"] = id
+	rule := getPolicyRuleFromElem(data, sequenceNumber)
+	if len(d.Id()) > 0 {
+		// This is update flow
+		revision := int64(d.Get("revision").(int))
+		rule.Revision = &revision
+	}
+
+	log.Printf("[INFO] Patching Rule with ID %s in policy %s", id, policyPath)
+	if isPolicyGlobalManager(m) {
+		gmObj, convErr := convertModelBindingType(rule, model.RuleBindingType(), gm_model.RuleBindingType())
+		if convErr != nil {
+			return convErr
+		}
+		if isGatewayPolicy {
+			return gm_gateway_policies.NewRulesClient(connector).Patch(domain, policyID, id, gmObj.(gm_model.Rule))
+		}
+		return gm_security_policies.NewRulesClient(connector).Patch(domain, policyID, id, gmObj.(gm_model.Rule))
+	}
+
+	if isGatewayPolicy {
+		return gateway_policies.NewRulesClient(connector).Patch(domain, policyID, id, rule)
+	}
+	return security_policies.NewRulesClient(connector).Patch(domain, policyID, id, rule)
+}
+
+func resourceNsxtPolicySecurityPolicyRuleCreate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	policyPath := d.Get("policy_path").(string)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicySecurityPolicyRuleExistsPartial(policyPath))
+	if err != nil {
+		return err
+	}
+
+	var sequenceNumber int64
+	if value, ok := d.GetOk("sequence_number"); ok {
+		sequenceNumber = int64(value.(int))
+	} else {
+		sequenceNumber, err = getPolicyNextRuleSequenceNumber(connector, policyPath, isPolicyGlobalManager(m))
+		if err != nil {
+			return handleCreateError("Security Policy Rule", id, err)
+		}
+	}
+
+	err = policySecurityPolicyRulePatch(d, m, id, sequenceNumber)
+	if err != nil {
+		return handleCreateError("Security Policy Rule", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicySecurityPolicyRuleRead(d, m)
+}
+
+func resourceNsxtPolicySecurityPolicyRuleRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Security Policy Rule id")
+	}
+
+	obj, err := getPolicySecurityPolicyRule(connector, d.Get("policy_path").(string), id, isPolicyGlobalManager(m))
+	if err != nil {
+		return handleReadError(d, "Security Policy Rule", id, err)
+	}
+
+	for key, value := range getPolicyRuleElemFromModel(obj) {
+		d.Set(key, value)
+	}
+	d.Set("path", obj.Path)
+
+	return nil
+}
+
+func resourceNsxtPolicySecurityPolicyRuleUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Security Policy Rule id")
+	}
+
+	sequenceNumber := int64(d.Get("sequence_number").(int))
+	err := policySecurityPolicyRulePatch(d, m, id, sequenceNumber)
+	if err != nil {
+		return handleUpdateError("Security Policy Rule", id, err)
+	}
+
+	return resourceNsxtPolicySecurityPolicyRuleRead(d, m)
+}
+
+func resourceNsxtPolicySecurityPolicyRuleDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Security Policy Rule id")
+	}
+
+	connector := getPolicyConnector(m)
+	domain, policyID, isGatewayPolicy, err := parsePolicyPathForRule(d.Get("policy_path").(string))
+	if err != nil {
+		return err
+	}
+
+	if isPolicyGlobalManager(m) {
+		if isGatewayPolicy {
+			err = gm_gateway_policies.NewRulesClient(connector).Delete(domain, policyID, id)
+		} else {
+			err = gm_security_policies.NewRulesClient(connector).Delete(domain, policyID, id)
+		}
+	} else {
+		if isGatewayPolicy {
+			err = gateway_policies.NewRulesClient(connector).Delete(domain, policyID, id)
+		} else {
+			err = security_policies.NewRulesClient(connector).Delete(domain, policyID, id)
+		}
+	}
+
+	if err != nil {
+		return handleDeleteError("Security Policy Rule", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicySecurityPolicyRuleImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importPath := d.Id()
+	idx := strings.LastIndex(importPath, "/rules/")
+	if idx <= 0 {
+		return nil, fmt.Errorf("Please provide rule path as an input, for example /infra/domains/default/security-policies/<policy-id>/rules/<rule-id>")
+	}
+
+	policyPath := importPath[:idx]
+	if _, _, _, err := parsePolicyPathForRule(policyPath); err != nil {
+		return nil, err
+	}
+
+	d.SetId(importPath[idx+len("/rules/"):])
+	d.Set("policy_path", policyPath)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,285 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicySecurityPolicyRuleCreateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform created",
+	"action":       "ALLOW",
+	"direction":    "IN",
+	"ip_version":   "IPV4",
+	"logged":       "true",
+	"log_label":    "tfrule",
+	"notes":        "created",
+}
+
+var accTestPolicySecurityPolicyRuleUpdateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform updated",
+	"action":       "DROP",
+	"direction":    "OUT",
+	"ip_version":   "IPV4_IPV6",
+	"logged":       "false",
+	"log_label":    "tfrule2",
+	"notes":        "updated",
+}
+
+func TestAccResourceNsxtPolicySecurityPolicyRule_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_security_policy_rule.test"
+	policyName := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySecurityPolicyRuleCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySecurityPolicyRuleTemplate(policyName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySecurityPolicyRuleExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicySecurityPolicyRuleCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicySecurityPolicyRuleCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "action", accTestPolicySecurityPolicyRuleCreateAttributes["action"]),
+					resource.TestCheckResourceAttr(testResourceName, "direction", accTestPolicySecurityPolicyRuleCreateAttributes["direction"]),
+					resource.TestCheckResourceAttr(testResourceName, "ip_version", accTestPolicySecurityPolicyRuleCreateAttributes["ip_version"]),
+					resource.TestCheckResourceAttr(testResourceName, "logged", accTestPolicySecurityPolicyRuleCreateAttributes["logged"]),
+					resource.TestCheckResourceAttr(testResourceName, "log_label", accTestPolicySecurityPolicyRuleCreateAttributes["log_label"]),
+					resource.TestCheckResourceAttr(testResourceName, "notes", accTestPolicySecurityPolicyRuleCreateAttributes["notes"]),
+					resource.TestCheckResourceAttr(testResourceName, "source_groups.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "services.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "sequence_number", "0"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rule_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "policy_path"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicySecurityPolicyRuleTemplate(policyName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySecurityPolicyRuleExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicySecurityPolicyRuleUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicySecurityPolicyRuleUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "action", accTestPolicySecurityPolicyRuleUpdateAttributes["action"]),
+					resource.TestCheckResourceAttr(testResourceName, "direction", accTestPolicySecurityPolicyRuleUpdateAttributes["direction"]),
+					resource.TestCheckResourceAttr(testResourceName, "ip_version", accTestPolicySecurityPolicyRuleUpdateAttributes["ip_version"]),
+					resource.TestCheckResourceAttr(testResourceName, "logged", accTestPolicySecurityPolicyRuleUpdateAttributes["logged"]),
+					resource.TestCheckResourceAttr(testResourceName, "log_label", accTestPolicySecurityPolicyRuleUpdateAttributes["log_label"]),
+					resource.TestCheckResourceAttr(testResourceName, "notes", accTestPolicySecurityPolicyRuleUpdateAttributes["notes"]),
+					resource.TestCheckResourceAttr(testResourceName, "source_groups.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "services.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "sequence_number", "0"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rule_id"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicySecurityPolicyRuleMinimalistic(policyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySecurityPolicyRuleExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "source_groups.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicySecurityPolicyRule_gatewayPolicy(t *testing.T) {
+	testResourceName := "nsxt_policy_security_policy_rule.test"
+	policyName := getAccTestResourceName()
+	name := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySecurityPolicyRuleCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySecurityPolicyRuleGatewayPolicy(policyName, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySecurityPolicyRuleExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "scope.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "sequence_number", "5"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicySecurityPolicyRule_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_security_policy_rule.test"
+	policyName := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySecurityPolicyRuleCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySecurityPolicyRuleMinimalistic(policyName),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccNsxtPolicySecurityPolicyRuleImporterGetID,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySecurityPolicyRuleImporterGetID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["nsxt_policy_security_policy_rule.test"]
+	if !ok {
+		return "", fmt.Errorf("NSX Policy Security Policy Rule resource not found in resources")
+	}
+	path := rs.Primary.Attributes["path"]
+	if path == "" {
+		return "", fmt.Errorf("NSX Policy Security Policy Rule path not set in resources")
+	}
+	return path, nil
+}
+
+func testAccNsxtPolicySecurityPolicyRuleExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Security Policy Rule resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Security Policy Rule resource ID not set in resources")
+		}
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+		exists, err := resourceNsxtPolicySecurityPolicyRuleExistsPartial(rs.Primary.Attributes["policy_path"])(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Security Policy Rule %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicySecurityPolicyRuleCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "nsxt_policy_security_policy_rule" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicySecurityPolicyRuleExistsPartial(rs.Primary.Attributes["policy_path"])(resourceID, connector, testAccIsGlobalManager())
+		if err == nil && exists {
+			return fmt.Errorf("Policy Security Policy Rule %s still exists", resourceID)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicySecurityPolicyRuleParentPolicy(policyName string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_security_policy" "test" {
+  display_name = "%s"
+  category     = "Application"
+
+  lifecycle {
+    ignore_changes = [rule]
+  }
+}`, policyName, policyName)
+}
+
+func testAccNsxtPolicySecurityPolicyRuleTemplate(policyName string, createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicySecurityPolicyRuleCreateAttributes
+	} else {
+		attrMap = accTestPolicySecurityPolicyRuleUpdateAttributes
+	}
+	return testAccNsxtPolicySecurityPolicyRuleParentPolicy(policyName) + fmt.Sprintf(`
+resource "nsxt_policy_security_policy_rule" "test" {
+  policy_path   = nsxt_policy_security_policy.test.path
+  display_name  = "%s"
+  description   = "%s"
+  action        = "%s"
+  direction     = "%s"
+  ip_version    = "%s"
+  logged        = %s
+  log_label     = "%s"
+  notes         = "%s"
+  source_groups = [nsxt_policy_group.test.path]
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["action"], attrMap["direction"], attrMap["ip_version"], attrMap["logged"], attrMap["log_label"], attrMap["notes"])
+}
+
+func testAccNsxtPolicySecurityPolicyRuleMinimalistic(policyName string) string {
+	return testAccNsxtPolicySecurityPolicyRuleParentPolicy(policyName) + fmt.Sprintf(`
+resource "nsxt_policy_security_policy_rule" "test" {
+  policy_path  = nsxt_policy_security_policy.test.path
+  display_name = "%s"
+}`, accTestPolicySecurityPolicyRuleUpdateAttributes["display_name"])
+}
+
+func testAccNsxtPolicySecurityPolicyRuleGatewayPolicy(policyName string, name string) string {
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) + testAccNsxtPolicyTier1WithEdgeClusterTemplate("test", false) + fmt.Sprintf(`
+resource "nsxt_policy_gateway_policy" "test" {
+  display_name = "%s"
+  category     = "LocalGatewayRules"
+
+  lifecycle {
+    ignore_changes = [rule]
+  }
+}
+
+resource "nsxt_policy_security_policy_rule" "test" {
+  policy_path     = nsxt_policy_gateway_policy.test.path
+  display_name    = "%s"
+  sequence_number = 5
+  scope           = [nsxt_policy_tier1_gateway.test.path]
+}`, policyName, name)
+}
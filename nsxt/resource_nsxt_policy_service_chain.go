@@ -0,0 +1,218 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+var serviceChainFailurePolicyValues = []string{
+	model.PolicyServiceChain_FAILURE_POLICY_ALLOW,
+	model.PolicyServiceChain_FAILURE_POLICY_BLOCK,
+}
+
+var serviceChainPathSelectionPolicyValues = []string{
+	model.PolicyServiceChain_PATH_SELECTION_POLICY_ANY,
+	model.PolicyServiceChain_PATH_SELECTION_POLICY_LOCAL,
+	model.PolicyServiceChain_PATH_SELECTION_POLICY_REMOTE,
+	model.PolicyServiceChain_PATH_SELECTION_POLICY_ROUND_ROBIN,
+}
+
+func getServiceChainProfilesSchema(required bool, description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Required:    required,
+		Optional:    !required,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validatePolicyPath(),
+		},
+	}
+}
+
+func resourceNsxtPolicyServiceChain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyServiceChainCreate,
+		Read:   resourceNsxtPolicyServiceChainRead,
+		Update: resourceNsxtPolicyServiceChainUpdate,
+		Delete: resourceNsxtPolicyServiceChainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"service_segment_path": {
+				Type:        schema.TypeList,
+				Description: "Path of service segment used to redirect traffic",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validatePolicyPath(),
+				},
+			},
+			"forward_path_service_profiles": getServiceChainProfilesSchema(true, "Ordered list of service profile paths applied to ingress traffic"),
+			"reverse_path_service_profiles": getServiceChainProfilesSchema(false, "Ordered list of service profile paths applied to egress traffic. If not specified, reverse of forward path is used"),
+			"failure_policy": {
+				Type:         schema.TypeString,
+				Description:  "Action to take on traffic when service chain fails",
+				Optional:     true,
+				Default:      model.PolicyServiceChain_FAILURE_POLICY_ALLOW,
+				ValidateFunc: validation.StringInSlice(serviceChainFailurePolicyValues, false),
+			},
+			"path_selection_policy": {
+				Type:         schema.TypeString,
+				Description:  "Preference for selecting service instances on the path",
+				Optional:     true,
+				Default:      model.PolicyServiceChain_PATH_SELECTION_POLICY_ANY,
+				ValidateFunc: validation.StringInSlice(serviceChainPathSelectionPolicyValues, false),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyServiceChainExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	client := infra.NewServiceChainsClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getPolicyServiceChainFromSchema(d *schema.ResourceData) model.PolicyServiceChain {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	failurePolicy := d.Get("failure_policy").(string)
+	pathSelectionPolicy := d.Get("path_selection_policy").(string)
+
+	return model.PolicyServiceChain{
+		DisplayName:                &displayName,
+		Description:                &description,
+		Tags:                       tags,
+		FailurePolicy:              &failurePolicy,
+		PathSelectionPolicy:        &pathSelectionPolicy,
+		ServiceSegmentPath:         interface2StringList(d.Get("service_segment_path").([]interface{})),
+		ForwardPathServiceProfiles: interface2StringList(d.Get("forward_path_service_profiles").([]interface{})),
+		ReversePathServiceProfiles: interface2StringList(d.Get("reverse_path_service_profiles").([]interface{})),
+	}
+}
+
+func resourceNsxtPolicyServiceChainCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return resourceNotSupportedError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyServiceChainExists)
+	if err != nil {
+		return err
+	}
+
+	obj := getPolicyServiceChainFromSchema(d)
+
+	// Create the resource using PATCH
+	log.Printf("[INFO] Creating Service Chain with ID %s", id)
+	client := infra.NewServiceChainsClient(connector)
+	err = client.Patch(id, obj)
+	if err != nil {
+		return handleCreateError("Service Chain", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyServiceChainRead(d, m)
+}
+
+func resourceNsxtPolicyServiceChainRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Service Chain ID")
+	}
+
+	client := infra.NewServiceChainsClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "Service Chain", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("service_segment_path", obj.ServiceSegmentPath)
+	d.Set("forward_path_service_profiles", obj.ForwardPathServiceProfiles)
+	d.Set("reverse_path_service_profiles", obj.ReversePathServiceProfiles)
+	d.Set("failure_policy", obj.FailurePolicy)
+	d.Set("path_selection_policy", obj.PathSelectionPolicy)
+
+	return nil
+}
+
+func resourceNsxtPolicyServiceChainUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Service Chain ID")
+	}
+
+	obj := getPolicyServiceChainFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+
+	// Update the resource using PUT, so that reverse path profiles removed from
+	// configuration are cleared on NSX
+	client := infra.NewServiceChainsClient(connector)
+	_, err := client.Update(id, obj)
+	if err != nil {
+		return handleUpdateError("Service Chain", id, err)
+	}
+
+	return resourceNsxtPolicyServiceChainRead(d, m)
+}
+
+func resourceNsxtPolicyServiceChainDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Service Chain ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewServiceChainsClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("Service Chain", id, err)
+	}
+
+	return nil
+}
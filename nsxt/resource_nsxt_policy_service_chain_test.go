@@ -0,0 +1,109 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testAccNsxtPolicyServiceChainPreCheck(t *testing.T) {
+	testAccPreCheck(t)
+	testAccOnlyLocalManager(t)
+	testAccEnvDefined(t, "NSXT_TEST_SERVICE_SEGMENT_PATH")
+	testAccEnvDefined(t, "NSXT_TEST_SERVICE_PROFILE_PATH")
+}
+
+func TestAccResourceNsxtPolicyServiceChain_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_policy_service_chain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccNsxtPolicyServiceChainPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, updatedName, "nsxt_policy_service_chain", resourceNsxtPolicyServiceChainExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyServiceChainTemplate(name, "ALLOW", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyServiceChainExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "failure_policy", "ALLOW"),
+					resource.TestCheckResourceAttr(testResourceName, "path_selection_policy", "ANY"),
+					resource.TestCheckResourceAttr(testResourceName, "service_segment_path.0", getTestServiceSegmentPath()),
+					resource.TestCheckResourceAttr(testResourceName, "forward_path_service_profiles.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "reverse_path_service_profiles.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyServiceChainTemplate(updatedName, "BLOCK", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyServiceChainExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "failure_policy", "BLOCK"),
+					resource.TestCheckResourceAttr(testResourceName, "forward_path_service_profiles.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "reverse_path_service_profiles.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyServiceChain_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_service_chain.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccNsxtPolicyServiceChainPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_service_chain", resourceNsxtPolicyServiceChainExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyServiceChainTemplate(name, "ALLOW", true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyServiceChainTemplate(name string, failurePolicy string, withReverse bool) string {
+	reverse := ""
+	if withReverse {
+		reverse = fmt.Sprintf(`reverse_path_service_profiles = ["%s"]`, getTestServiceProfilePath())
+	}
+
+	return fmt.Sprintf(`
+resource "nsxt_policy_service_chain" "test" {
+  display_name                  = "%s"
+  description                   = "Acceptance Test"
+  service_segment_path          = ["%s"]
+  forward_path_service_profiles = ["%s"]
+  failure_policy                = "%s"
+  %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, name, getTestServiceSegmentPath(), getTestServiceProfilePath(), failurePolicy, reverse)
+}
@@ -575,6 +575,48 @@ func TestAccResourceNsxtPolicyService_algType(t *testing.T) {
 	})
 }
 
+func TestAccResourceNsxtPolicyService_nestedServiceType(t *testing.T) {
+	name := getAccTestResourceName()
+	updateName := getAccTestResourceName()
+	testResourceName := "nsxt_policy_service.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyServiceCheckDestroy(state, updateName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyNestedServiceCreateTemplate(name, "nested1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyServiceExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Nested service entry"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "nested_service_entry.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "nested_service_entry.0.display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "nested_service_entry.0.description", "Entry-1"),
+					resource.TestCheckResourceAttrPair(testResourceName, "nested_service_entry.0.nested_service_path", "nsxt_policy_service.nested1", "path"),
+					resource.TestCheckResourceAttr(testResourceName, "l4_port_set_entry.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "algorithm_entry.#", "0"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyNestedServiceCreateTemplate(updateName, "nested2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyServiceExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updateName),
+					resource.TestCheckResourceAttr(testResourceName, "nested_service_entry.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "nested_service_entry.0.display_name", updateName),
+					resource.TestCheckResourceAttrPair(testResourceName, "nested_service_entry.0.nested_service_path", "nsxt_policy_service.nested2", "path"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceNsxtPolicyService_importBasic(t *testing.T) {
 	name := getAccTestResourceName()
 	testResourceName := "nsxt_policy_service.test"
@@ -921,3 +963,35 @@ resource "nsxt_policy_service" "test" {
   }
 }`, serviceName, serviceName, alg, sourcePorts, destPort)
 }
+
+func testAccNsxtPolicyNestedServiceCreateTemplate(serviceName string, nestedService string) string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_service" "nested1" {
+  display_name = "%s-1"
+
+  l4_port_set_entry {
+    protocol          = "TCP"
+    destination_ports = ["443"]
+  }
+}
+
+resource "nsxt_policy_service" "nested2" {
+  display_name = "%s-2"
+
+  l4_port_set_entry {
+    protocol          = "TCP"
+    destination_ports = ["8443"]
+  }
+}
+
+resource "nsxt_policy_service" "test" {
+  description  = "Nested service entry"
+  display_name = "%s"
+
+  nested_service_entry {
+    display_name        = "%s"
+    description         = "Entry-1"
+    nested_service_path = nsxt_policy_service.%s.path
+  }
+}`, serviceName, serviceName, serviceName, serviceName, nestedService)
+}
@@ -0,0 +1,498 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+const (
+	tlsInspectionModeInternal = "INTERNAL"
+	tlsInspectionModeExternal = "EXTERNAL"
+)
+
+var tlsInspectionModeValues = []string{
+	tlsInspectionModeInternal,
+	tlsInspectionModeExternal,
+}
+
+var tlsInspectionDecryptionFailActionValues = []string{
+	model.TlsInspectionInternalProfile_DECRYPTION_FAIL_ACTION_BLOCK,
+	model.TlsInspectionInternalProfile_DECRYPTION_FAIL_ACTION_BYPASS,
+}
+
+var tlsInspectionCryptoEnforcementValues = []string{
+	model.TlsInspectionInternalProfile_CRYPTO_ENFORCEMENT_ENFORCE,
+	model.TlsInspectionInternalProfile_CRYPTO_ENFORCEMENT_TRANSPARENT,
+}
+
+var tlsInspectionConfigSettingValues = []string{
+	model.TlsInspectionInternalProfile_TLS_CONFIG_SETTING_BALANCED,
+	model.TlsInspectionInternalProfile_TLS_CONFIG_SETTING_HIGH_FIDELITY,
+	model.TlsInspectionInternalProfile_TLS_CONFIG_SETTING_HIGH_SECURITY,
+	model.TlsInspectionInternalProfile_TLS_CONFIG_SETTING_CUSTOM,
+}
+
+var tlsInspectionVersionValues = []string{
+	model.TlsInspectionInternalProfile_CLIENT_MIN_TLS_VERSION_0,
+	model.TlsInspectionInternalProfile_CLIENT_MIN_TLS_VERSION_1,
+	model.TlsInspectionInternalProfile_CLIENT_MIN_TLS_VERSION_2,
+}
+
+var tlsInspectionCipherSuiteValues = []string{
+	model.TlsInspectionInternalProfile_CLIENT_CIPHER_SUITE_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+	model.TlsInspectionInternalProfile_CLIENT_CIPHER_SUITE_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+	model.TlsInspectionInternalProfile_CLIENT_CIPHER_SUITE_ECDHE_RSA_WITH_AES_128_CBC_SHA,
+	model.TlsInspectionInternalProfile_CLIENT_CIPHER_SUITE_ECDHE_RSA_WITH_AES_256_CBC_SHA,
+	model.TlsInspectionInternalProfile_CLIENT_CIPHER_SUITE_ECDHE_RSA_WITH_AES_128_CBC_SHA256,
+	model.TlsInspectionInternalProfile_CLIENT_CIPHER_SUITE_ECDHE_RSA_WITH_AES_256_CBC_SHA384,
+	model.TlsInspectionInternalProfile_CLIENT_CIPHER_SUITE_RSA_WITH_AES_128_GCM_SHA256,
+	model.TlsInspectionInternalProfile_CLIENT_CIPHER_SUITE_RSA_WITH_AES_128_CBC_SHA256,
+	model.TlsInspectionInternalProfile_CLIENT_CIPHER_SUITE_RSA_WITH_AES_256_GCM_SHA384,
+	model.TlsInspectionInternalProfile_CLIENT_CIPHER_SUITE_RSA_WITH_AES_256_CBC_SHA256,
+	model.TlsInspectionInternalProfile_CLIENT_CIPHER_SUITE_RSA_WITH_AES_256_CBC_SHA,
+	model.TlsInspectionInternalProfile_CLIENT_CIPHER_SUITE_RSA_WITH_AES_128_CBC_SHA,
+}
+
+var tlsInspectionInvalidCertActionValues = []string{
+	model.TlsInspectionExternalProfile_INVALID_CERT_ACTION_BLOCK,
+	model.TlsInspectionExternalProfile_INVALID_CERT_ACTION_ALLOW,
+}
+
+// Attributes that are only applicable to a certain inspection mode
+var tlsInspectionInternalOnlyAttrs = []string{"server_certificates", "default_certificate", "certificate_validation"}
+var tlsInspectionExternalOnlyAttrs = []string{"proxy_trusted_ca_certificate", "proxy_untrusted_ca_certificate", "invalid_certificate_action"}
+
+func getTLSInspectionVersionSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  description,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice(tlsInspectionVersionValues, false),
+	}
+}
+
+func getTLSInspectionCipherSuitesSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(tlsInspectionCipherSuiteValues, false),
+		},
+	}
+}
+
+func getTLSInspectionPathSetSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: description,
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validatePolicyPath(),
+		},
+	}
+}
+
+func resourceNsxtPolicyTLSInspectionActionProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyTLSInspectionActionProfileCreate,
+		Read:   resourceNsxtPolicyTLSInspectionActionProfileRead,
+		Update: resourceNsxtPolicyTLSInspectionActionProfileUpdate,
+		Delete: resourceNsxtPolicyTLSInspectionActionProfileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"mode": {
+				Type:         schema.TypeString,
+				Description:  "Inspection mode, INTERNAL for traffic to internal servers or EXTERNAL for traffic to external servers",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(tlsInspectionModeValues, false),
+			},
+			"decryption_fail_action": {
+				Type:         schema.TypeString,
+				Description:  "Action to take when TLS handshake fails",
+				Optional:     true,
+				Default:      model.TlsInspectionInternalProfile_DECRYPTION_FAIL_ACTION_BLOCK,
+				ValidateFunc: validation.StringInSlice(tlsInspectionDecryptionFailActionValues, false),
+			},
+			"crypto_enforcement": {
+				Type:         schema.TypeString,
+				Description:  "Whether to terminate connections that do not use permitted TLS versions and ciphers",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(tlsInspectionCryptoEnforcementValues, false),
+			},
+			"tls_config_setting": {
+				Type:         schema.TypeString,
+				Description:  "Pre-defined TLS version and cipher settings",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(tlsInspectionConfigSettingValues, false),
+			},
+			"client_min_tls_version": getTLSInspectionVersionSchema("Client minimum TLS version to enforce"),
+			"client_max_tls_version": getTLSInspectionVersionSchema("Client maximum TLS version to enforce"),
+			"server_min_tls_version": getTLSInspectionVersionSchema("Server minimum TLS version to enforce"),
+			"server_max_tls_version": getTLSInspectionVersionSchema("Server maximum TLS version to enforce"),
+			"client_cipher_suites":   getTLSInspectionCipherSuitesSchema("Client cipher suites to enforce"),
+			"server_cipher_suites":   getTLSInspectionCipherSuitesSchema("Server cipher suites to enforce"),
+			"idle_connection_timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Timeout in seconds for idle connections",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"ocsp_must_staple": {
+				Type:        schema.TypeBool,
+				Description: "Enable OCSP must staple",
+				Optional:    true,
+				Default:     false,
+			},
+			"trusted_ca_bundles":  getTLSInspectionPathSetSchema("Policy paths of trusted CA bundles"),
+			"crls":                getTLSInspectionPathSetSchema("Policy paths of certificate revocation lists"),
+			"server_certificates": getTLSInspectionPathSetSchema("Policy paths of server certificates presented to the client, applicable for INTERNAL mode"),
+			"default_certificate": getPolicyPathSchema(false, false, "Policy path of default server certificate, applicable for INTERNAL mode"),
+			"certificate_validation": {
+				Type:        schema.TypeBool,
+				Description: "Enable certificate validation, applicable for INTERNAL mode",
+				Optional:    true,
+				Computed:    true,
+			},
+			"proxy_trusted_ca_certificate":   getPolicyPathSchema(false, false, "Policy path of proxy CA certificate used to issue certificates for valid servers, applicable for EXTERNAL mode"),
+			"proxy_untrusted_ca_certificate": getPolicyPathSchema(false, false, "Policy path of proxy CA certificate used to issue certificates for invalid servers, applicable for EXTERNAL mode"),
+			"invalid_certificate_action": {
+				Type:         schema.TypeString,
+				Description:  "Action to take when server presents invalid certificate, applicable for EXTERNAL mode",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(tlsInspectionInvalidCertActionValues, false),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyTLSInspectionActionProfileExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	client := infra.NewTlsInspectionActionProfilesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func validateTLSInspectionActionProfileSchema(d *schema.ResourceData) error {
+	mode := d.Get("mode").(string)
+	invalidAttrs := tlsInspectionExternalOnlyAttrs
+	if mode == tlsInspectionModeExternal {
+		invalidAttrs = tlsInspectionInternalOnlyAttrs
+	}
+
+	for _, attr := range invalidAttrs {
+		if _, ok := d.GetOk(attr); ok {
+			return fmt.Errorf("%s is not applicable for %s mode", attr, mode)
+		}
+	}
+
+	return nil
+}
+
+func policyTLSInspectionActionProfilePatch(d *schema.ResourceData, m interface{}, id string, isUpdate bool) error {
+	if err := validateTLSInspectionActionProfileSchema(d); err != nil {
+		return err
+	}
+
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	decryptionFailAction := d.Get("decryption_fail_action").(string)
+	ocspMustStaple := d.Get("ocsp_must_staple").(bool)
+	trustedCaBundles := getStringListFromSchemaSet(d, "trusted_ca_bundles")
+	crls := getStringListFromSchemaSet(d, "crls")
+	clientCipherSuites := getStringListFromSchemaSet(d, "client_cipher_suites")
+	serverCipherSuites := getStringListFromSchemaSet(d, "server_cipher_suites")
+
+	var revision *int64
+	if isUpdate {
+		rev := int64(d.Get("revision").(int))
+		revision = &rev
+	}
+
+	// Computed attributes should only be set if they have a value specified
+	var cryptoEnforcement, configSetting, clientMinVersion, clientMaxVersion, serverMinVersion, serverMaxVersion *string
+	var idleTimeout *int64
+	if value := d.Get("crypto_enforcement").(string); value != "" {
+		cryptoEnforcement = &value
+	}
+	if value := d.Get("tls_config_setting").(string); value != "" {
+		configSetting = &value
+	}
+	if value := d.Get("client_min_tls_version").(string); value != "" {
+		clientMinVersion = &value
+	}
+	if value := d.Get("client_max_tls_version").(string); value != "" {
+		clientMaxVersion = &value
+	}
+	if value := d.Get("server_min_tls_version").(string); value != "" {
+		serverMinVersion = &value
+	}
+	if value := d.Get("server_max_tls_version").(string); value != "" {
+		serverMaxVersion = &value
+	}
+	if value, ok := d.GetOk("idle_connection_timeout"); ok {
+		timeout := int64(value.(int))
+		idleTimeout = &timeout
+	}
+
+	var dataValue data.DataValue
+	var errs []error
+	if d.Get("mode").(string) == tlsInspectionModeInternal {
+		obj := model.TlsInspectionInternalProfile{
+			DisplayName:           &displayName,
+			Description:           &description,
+			Tags:                  tags,
+			Revision:              revision,
+			ResourceType:          model.TlsProfile_RESOURCE_TYPE_TLSINSPECTIONINTERNALPROFILE,
+			DecryptionFailAction:  &decryptionFailAction,
+			OcspMustStaple:        &ocspMustStaple,
+			TrustedCaBundles:      trustedCaBundles,
+			Crls:                  crls,
+			ClientCipherSuite:     clientCipherSuites,
+			ServerCipherSuite:     serverCipherSuites,
+			CryptoEnforcement:     cryptoEnforcement,
+			TlsConfigSetting:      configSetting,
+			ClientMinTlsVersion:   clientMinVersion,
+			ClientMaxTlsVersion:   clientMaxVersion,
+			ServerMinTlsVersion:   serverMinVersion,
+			ServerMaxTlsVersion:   serverMaxVersion,
+			IdleConnectionTimeout: idleTimeout,
+			ServerCertsKey:        getStringListFromSchemaSet(d, "server_certificates"),
+		}
+		if value := d.Get("default_certificate").(string); value != "" {
+			obj.DefaultCertKey = &value
+		}
+		if value, ok := d.GetOkExists("certificate_validation"); ok {
+			validate := value.(bool)
+			obj.CertificateValidation = &validate
+		}
+		dataValue, errs = converter.ConvertToVapi(obj, model.TlsInspectionInternalProfileBindingType())
+	} else {
+		obj := model.TlsInspectionExternalProfile{
+			DisplayName:           &displayName,
+			Description:           &description,
+			Tags:                  tags,
+			Revision:              revision,
+			ResourceType:          model.TlsProfile_RESOURCE_TYPE_TLSINSPECTIONEXTERNALPROFILE,
+			DecryptionFailAction:  &decryptionFailAction,
+			OcspMustStaple:        &ocspMustStaple,
+			TrustedCaBundles:      trustedCaBundles,
+			Crls:                  crls,
+			ClientCipherSuite:     clientCipherSuites,
+			ServerCipherSuite:     serverCipherSuites,
+			CryptoEnforcement:     cryptoEnforcement,
+			TlsConfigSetting:      configSetting,
+			ClientMinTlsVersion:   clientMinVersion,
+			ClientMaxTlsVersion:   clientMaxVersion,
+			ServerMinTlsVersion:   serverMinVersion,
+			ServerMaxTlsVersion:   serverMaxVersion,
+			IdleConnectionTimeout: idleTimeout,
+		}
+		if value := d.Get("proxy_trusted_ca_certificate").(string); value != "" {
+			obj.ProxyTrustedCaCert = &value
+		}
+		if value := d.Get("proxy_untrusted_ca_certificate").(string); value != "" {
+			obj.ProxyUntrustedCaCert = &value
+		}
+		if value := d.Get("invalid_certificate_action").(string); value != "" {
+			obj.InvalidCertAction = &value
+		}
+		dataValue, errs = converter.ConvertToVapi(obj, model.TlsInspectionExternalProfileBindingType())
+	}
+
+	if errs != nil {
+		return fmt.Errorf("Error converting TLS Inspection Action Profile: %v", errs[0])
+	}
+
+	client := infra.NewTlsInspectionActionProfilesClient(connector)
+	if isUpdate {
+		// Update the resource using PUT, so that attributes removed from configuration
+		// are cleared on NSX
+		_, err := client.Update(id, dataValue.(*data.StructValue))
+		return err
+	}
+
+	log.Printf("[INFO] Creating TLS Inspection Action Profile with ID %s", id)
+	_, err := client.Patch(id, dataValue.(*data.StructValue))
+	return err
+}
+
+func resourceNsxtPolicyTLSInspectionActionProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return resourceNotSupportedError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyTLSInspectionActionProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = policyTLSInspectionActionProfilePatch(d, m, id, false)
+	if err != nil {
+		return handleCreateError("TLS Inspection Action Profile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyTLSInspectionActionProfileRead(d, m)
+}
+
+func resourceNsxtPolicyTLSInspectionActionProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TLS Inspection Action Profile ID")
+	}
+
+	client := infra.NewTlsInspectionActionProfilesClient(connector)
+	dataValue, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "TLS Inspection Action Profile", id, err)
+	}
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+	baseObj, errs := converter.ConvertToGolang(dataValue, model.TlsProfileBindingType())
+	if len(errs) > 0 {
+		return fmt.Errorf("Error converting TLS Inspection Action Profile %s: %v", id, errs[0])
+	}
+
+	switch baseObj.(model.TlsProfile).ResourceType {
+	case model.TlsProfile_RESOURCE_TYPE_TLSINSPECTIONINTERNALPROFILE:
+		rawObj, errs := converter.ConvertToGolang(dataValue, model.TlsInspectionInternalProfileBindingType())
+		if len(errs) > 0 {
+			return fmt.Errorf("Error converting TLS Inspection Action Profile %s: %v", id, errs[0])
+		}
+		obj := rawObj.(model.TlsInspectionInternalProfile)
+		d.Set("mode", tlsInspectionModeInternal)
+		d.Set("display_name", obj.DisplayName)
+		d.Set("description", obj.Description)
+		setPolicyTagsInSchema(d, obj.Tags)
+		d.Set("path", obj.Path)
+		d.Set("revision", obj.Revision)
+		d.Set("decryption_fail_action", obj.DecryptionFailAction)
+		d.Set("crypto_enforcement", obj.CryptoEnforcement)
+		d.Set("tls_config_setting", obj.TlsConfigSetting)
+		d.Set("client_min_tls_version", obj.ClientMinTlsVersion)
+		d.Set("client_max_tls_version", obj.ClientMaxTlsVersion)
+		d.Set("server_min_tls_version", obj.ServerMinTlsVersion)
+		d.Set("server_max_tls_version", obj.ServerMaxTlsVersion)
+		d.Set("client_cipher_suites", obj.ClientCipherSuite)
+		d.Set("server_cipher_suites", obj.ServerCipherSuite)
+		d.Set("idle_connection_timeout", obj.IdleConnectionTimeout)
+		d.Set("ocsp_must_staple", obj.OcspMustStaple)
+		d.Set("trusted_ca_bundles", obj.TrustedCaBundles)
+		d.Set("crls", obj.Crls)
+		d.Set("server_certificates", obj.ServerCertsKey)
+		d.Set("default_certificate", obj.DefaultCertKey)
+		d.Set("certificate_validation", obj.CertificateValidation)
+	case model.TlsProfile_RESOURCE_TYPE_TLSINSPECTIONEXTERNALPROFILE:
+		rawObj, errs := converter.ConvertToGolang(dataValue, model.TlsInspectionExternalProfileBindingType())
+		if len(errs) > 0 {
+			return fmt.Errorf("Error converting TLS Inspection Action Profile %s: %v", id, errs[0])
+		}
+		obj := rawObj.(model.TlsInspectionExternalProfile)
+		d.Set("mode", tlsInspectionModeExternal)
+		d.Set("display_name", obj.DisplayName)
+		d.Set("description", obj.Description)
+		setPolicyTagsInSchema(d, obj.Tags)
+		d.Set("path", obj.Path)
+		d.Set("revision", obj.Revision)
+		d.Set("decryption_fail_action", obj.DecryptionFailAction)
+		d.Set("crypto_enforcement", obj.CryptoEnforcement)
+		d.Set("tls_config_setting", obj.TlsConfigSetting)
+		d.Set("client_min_tls_version", obj.ClientMinTlsVersion)
+		d.Set("client_max_tls_version", obj.ClientMaxTlsVersion)
+		d.Set("server_min_tls_version", obj.ServerMinTlsVersion)
+		d.Set("server_max_tls_version", obj.ServerMaxTlsVersion)
+		d.Set("client_cipher_suites", obj.ClientCipherSuite)
+		d.Set("server_cipher_suites", obj.ServerCipherSuite)
+		d.Set("idle_connection_timeout", obj.IdleConnectionTimeout)
+		d.Set("ocsp_must_staple", obj.OcspMustStaple)
+		d.Set("trusted_ca_bundles", obj.TrustedCaBundles)
+		d.Set("crls", obj.Crls)
+		d.Set("proxy_trusted_ca_certificate", obj.ProxyTrustedCaCert)
+		d.Set("proxy_untrusted_ca_certificate", obj.ProxyUntrustedCaCert)
+		d.Set("invalid_certificate_action", obj.InvalidCertAction)
+	default:
+		return fmt.Errorf("TLS Inspection Action Profile %s has unsupported type %s", id, baseObj.(model.TlsProfile).ResourceType)
+	}
+
+	d.Set("nsx_id", id)
+
+	return nil
+}
+
+func resourceNsxtPolicyTLSInspectionActionProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TLS Inspection Action Profile ID")
+	}
+
+	err := policyTLSInspectionActionProfilePatch(d, m, id, true)
+	if err != nil {
+		return handleUpdateError("TLS Inspection Action Profile", id, err)
+	}
+
+	return resourceNsxtPolicyTLSInspectionActionProfileRead(d, m)
+}
+
+func resourceNsxtPolicyTLSInspectionActionProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TLS Inspection Action Profile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewTlsInspectionActionProfilesClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("TLS Inspection Action Profile", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyTLSInspectionActionProfile_internal(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_tls_inspection_action_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_CERTIFICATE_NAME")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_tls_inspection_action_profile", resourceNsxtPolicyTLSInspectionActionProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTLSInspectionActionProfileInternalTemplate(name, "BLOCK", "BALANCED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyTLSInspectionActionProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "mode", "INTERNAL"),
+					resource.TestCheckResourceAttr(testResourceName, "decryption_fail_action", "BLOCK"),
+					resource.TestCheckResourceAttr(testResourceName, "tls_config_setting", "BALANCED"),
+					resource.TestCheckResourceAttr(testResourceName, "server_certificates.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "default_certificate"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTLSInspectionActionProfileInternalTemplate(name, "BYPASS", "HIGH_SECURITY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyTLSInspectionActionProfileExists),
+					resource.TestCheckResourceAttr(testResourceName, "decryption_fail_action", "BYPASS"),
+					resource.TestCheckResourceAttr(testResourceName, "tls_config_setting", "HIGH_SECURITY"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyTLSInspectionActionProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_tls_inspection_action_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_CERTIFICATE_NAME")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_tls_inspection_action_profile", resourceNsxtPolicyTLSInspectionActionProfileExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTLSInspectionActionProfileInternalTemplate(name, "BLOCK", "BALANCED"),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyTLSInspectionActionProfileInternalTemplate(name string, failAction string, configSetting string) string {
+	return testAccNsxtPolicyCertificateReadTemplate(getTestCertificateName(false)) + fmt.Sprintf(`
+resource "nsxt_policy_tls_inspection_action_profile" "test" {
+  display_name           = "%s"
+  mode                   = "INTERNAL"
+  decryption_fail_action = "%s"
+  tls_config_setting     = "%s"
+  server_certificates    = [data.nsxt_policy_certificate.test.path]
+  default_certificate    = data.nsxt_policy_certificate.test.path
+
+  tag {
+    scope = "color"
+    tag   = "orange"
+  }
+}`, name, failAction, configSetting)
+}
@@ -0,0 +1,195 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyTLSInspectionConfigProfileBinding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyTLSInspectionConfigProfileBindingCreate,
+		Read:   resourceNsxtPolicyTLSInspectionConfigProfileBindingRead,
+		Update: resourceNsxtPolicyTLSInspectionConfigProfileBindingUpdate,
+		Delete: resourceNsxtPolicyTLSInspectionConfigProfileBindingDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyTLSInspectionConfigProfileBindingImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"gateway_path": getPolicyPathSchema(true, true, "Policy path of Tier-1 gateway to enable TLS inspection on"),
+			"profile_path": getPolicyPathSchema(true, false, "Policy path of TLS inspection config profile"),
+		},
+	}
+}
+
+func parseTLSInspectionGatewayPath(gwPath string) (string, error) {
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+	if gwID == "" || isT0 {
+		return "", fmt.Errorf("Invalid Tier-1 gateway path %s", gwPath)
+	}
+
+	return gwID, nil
+}
+
+func resourceNsxtPolicyTLSInspectionConfigProfileBindingExistsPartial(gwPath string) func(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	return func(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+		gwID, err := parseTLSInspectionGatewayPath(gwPath)
+		if err != nil {
+			return false, err
+		}
+
+		_, err = tier_1s.NewTlsInspectionConfigProfileBindingsClient(connector).Get(gwID, id)
+		if err == nil {
+			return true, nil
+		}
+
+		if isNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, logAPIError("Error retrieving TLS Inspection Config Profile Binding", err)
+	}
+}
+
+func policyTLSInspectionConfigProfileBindingPatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+	gwID, err := parseTLSInspectionGatewayPath(d.Get("gateway_path").(string))
+	if err != nil {
+		return err
+	}
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	profilePath := d.Get("profile_path").(string)
+
+	obj := model.TlsConfigProfileBindingMap{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		ProfilePath: &profilePath,
+	}
+
+	if len(d.Id()) > 0 {
+		// This is update flow
+		revision := int64(d.Get("revision").(int))
+		obj.Revision = &revision
+	}
+
+	log.Printf("[INFO] Patching TLS Inspection Config Profile Binding with ID %s", id)
+	_, err = tier_1s.NewTlsInspectionConfigProfileBindingsClient(connector).Patch(gwID, id, obj)
+	return err
+}
+
+func resourceNsxtPolicyTLSInspectionConfigProfileBindingCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return resourceNotSupportedError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyTLSInspectionConfigProfileBindingExistsPartial(d.Get("gateway_path").(string)))
+	if err != nil {
+		return err
+	}
+
+	err = policyTLSInspectionConfigProfileBindingPatch(d, m, id)
+	if err != nil {
+		return handleCreateError("TLS Inspection Config Profile Binding", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyTLSInspectionConfigProfileBindingRead(d, m)
+}
+
+func resourceNsxtPolicyTLSInspectionConfigProfileBindingRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TLS Inspection Config Profile Binding ID")
+	}
+
+	gwID, err := parseTLSInspectionGatewayPath(d.Get("gateway_path").(string))
+	if err != nil {
+		return err
+	}
+
+	obj, err := tier_1s.NewTlsInspectionConfigProfileBindingsClient(connector).Get(gwID, id)
+	if err != nil {
+		return handleReadError(d, "TLS Inspection Config Profile Binding", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("profile_path", obj.ProfilePath)
+
+	return nil
+}
+
+func resourceNsxtPolicyTLSInspectionConfigProfileBindingUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TLS Inspection Config Profile Binding ID")
+	}
+
+	err := policyTLSInspectionConfigProfileBindingPatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("TLS Inspection Config Profile Binding", id, err)
+	}
+
+	return resourceNsxtPolicyTLSInspectionConfigProfileBindingRead(d, m)
+}
+
+func resourceNsxtPolicyTLSInspectionConfigProfileBindingDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TLS Inspection Config Profile Binding ID")
+	}
+
+	connector := getPolicyConnector(m)
+	gwID, err := parseTLSInspectionGatewayPath(d.Get("gateway_path").(string))
+	if err != nil {
+		return err
+	}
+
+	err = tier_1s.NewTlsInspectionConfigProfileBindingsClient(connector).Delete(gwID, id)
+	if err != nil {
+		return handleDeleteError("TLS Inspection Config Profile Binding", id, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyTLSInspectionConfigProfileBindingImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importPath := d.Id()
+	separator := "/tls-inspection-config-profile-bindings/"
+	idx := strings.LastIndex(importPath, separator)
+	if idx <= 0 {
+		return nil, fmt.Errorf("Please provide binding path as an input, for example /infra/tier-1s/<gateway-id>%s<binding-id>", separator)
+	}
+
+	d.SetId(importPath[idx+len(separator):])
+	d.Set("gateway_path", importPath[:idx])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,132 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyTLSInspectionConfigProfileBinding_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_tls_inspection_config_profile_binding.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_TLS_CONFIG_PROFILE_PATH")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTLSInspectionConfigProfileBindingCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTLSInspectionConfigProfileBindingTemplate(name, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTLSInspectionConfigProfileBindingExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(testResourceName, "profile_path", getTestTLSConfigProfilePath()),
+					resource.TestCheckResourceAttrPair(testResourceName, "gateway_path", "nsxt_policy_tier1_gateway.test", "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTLSInspectionConfigProfileBindingTemplate(name, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTLSInspectionConfigProfileBindingExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyTLSInspectionConfigProfileBinding_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_tls_inspection_config_profile_binding.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_TLS_CONFIG_PROFILE_PATH")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTLSInspectionConfigProfileBindingCheckDestroy(state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTLSInspectionConfigProfileBindingTemplate(name, "description1"),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyPathImporterGetID(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyTLSInspectionConfigProfileBindingExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy TLS Inspection Config Profile Binding resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy TLS Inspection Config Profile Binding resource ID not set in resources")
+		}
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+		exists, err := resourceNsxtPolicyTLSInspectionConfigProfileBindingExistsPartial(rs.Primary.Attributes["gateway_path"])(resourceID, connector, false)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy TLS Inspection Config Profile Binding %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyTLSInspectionConfigProfileBindingCheckDestroy(state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != "nsxt_policy_tls_inspection_config_profile_binding" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyTLSInspectionConfigProfileBindingExistsPartial(rs.Primary.Attributes["gateway_path"])(resourceID, connector, false)
+		if err == nil && exists {
+			return fmt.Errorf("Policy TLS Inspection Config Profile Binding %s still exists", resourceID)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyTLSInspectionConfigProfileBindingTemplate(name string, description string) string {
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) +
+		testAccNsxtPolicyTier1WithEdgeClusterTemplate("test", false) + fmt.Sprintf(`
+
+resource "nsxt_policy_tls_inspection_config_profile_binding" "test" {
+  display_name = "%s"
+  description  = "%s"
+  gateway_path = nsxt_policy_tier1_gateway.test.path
+  profile_path = "%s"
+}`, name, description, getTestTLSConfigProfilePath())
+}
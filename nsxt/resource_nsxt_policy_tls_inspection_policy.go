@@ -0,0 +1,245 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func getTLSInspectionRulesSchema() *schema.Schema {
+	ruleSchema := getSecurityPolicyAndGatewayRulesSchema(false, false)
+	elemSchema := ruleSchema.Elem.(*schema.Resource).Schema
+
+	// TLS inspection rules take action from the action profile
+	delete(elemSchema, "action")
+	elemSchema["action_profile_path"] = getPolicyPathSchema(true, false, "Policy path of TLS inspection action profile")
+	ruleSchema.Description = "List of TLS inspection rules"
+
+	return ruleSchema
+}
+
+func resourceNsxtPolicyTLSInspectionPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNsxtPolicyTLSInspectionPolicyCreate,
+		Read:   resourceNsxtPolicyTLSInspectionPolicyRead,
+		Update: resourceNsxtPolicyTLSInspectionPolicyUpdate,
+		Delete: resourceNsxtPolicyTLSInspectionPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"sequence_number": {
+				Type:        schema.TypeInt,
+				Description: "Sequence number of this policy relative to other TLS inspection policies",
+				Optional:    true,
+				Default:     0,
+			},
+			"rule": getTLSInspectionRulesSchema(),
+		},
+	}
+}
+
+func resourceNsxtPolicyTLSInspectionPolicyExists(id string, connector *client.RestConnector, isGlobalManager bool) (bool, error) {
+	client := infra.NewTlsInspectionPoliciesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getPolicyTLSInspectionRulesFromSchema(d *schema.ResourceData) []model.TlsRule {
+	var ruleList []model.TlsRule
+	for seq, item := range d.Get("rule").([]interface{}) {
+		// TLS rules share all attributes with firewall rules, while action
+		// is defined by TLS profile
+		data := item.(map[string]interface{})
+		rule := getPolicyRuleFromElem(data, int64(seq))
+		actionProfilePath := data["action_profile_path"].(string)
+		resourceType := "TlsRule"
+		ruleList = append(ruleList, model.TlsRule{
+			ResourceType:         &resourceType,
+			Id:                   rule.Id,
+			DisplayName:          rule.DisplayName,
+			Description:          rule.Description,
+			Notes:                rule.Notes,
+			Logged:               rule.Logged,
+			Tag:                  rule.Tag,
+			Tags:                 rule.Tags,
+			Disabled:             rule.Disabled,
+			SourcesExcluded:      rule.SourcesExcluded,
+			DestinationsExcluded: rule.DestinationsExcluded,
+			IpProtocol:           rule.IpProtocol,
+			Direction:            rule.Direction,
+			SourceGroups:         rule.SourceGroups,
+			DestinationGroups:    rule.DestinationGroups,
+			Services:             rule.Services,
+			Scope:                rule.Scope,
+			Profiles:             rule.Profiles,
+			TlsProfile:           &actionProfilePath,
+			SequenceNumber:       rule.SequenceNumber,
+		})
+	}
+
+	return ruleList
+}
+
+func setPolicyTLSInspectionRulesInSchema(d *schema.ResourceData, rules []model.TlsRule) error {
+	var rulesList []map[string]interface{}
+	for _, rule := range rules {
+		elem := getPolicyRuleElemFromModel(model.Rule{
+			Id:                   rule.Id,
+			DisplayName:          rule.DisplayName,
+			Description:          rule.Description,
+			Revision:             rule.Revision,
+			Notes:                rule.Notes,
+			Logged:               rule.Logged,
+			Tag:                  rule.Tag,
+			Tags:                 rule.Tags,
+			Disabled:             rule.Disabled,
+			SourcesExcluded:      rule.SourcesExcluded,
+			DestinationsExcluded: rule.DestinationsExcluded,
+			IpProtocol:           rule.IpProtocol,
+			Direction:            rule.Direction,
+			SourceGroups:         rule.SourceGroups,
+			DestinationGroups:    rule.DestinationGroups,
+			Services:             rule.Services,
+			Scope:                rule.Scope,
+			Profiles:             rule.Profiles,
+			SequenceNumber:       rule.SequenceNumber,
+			RuleId:               rule.RuleId,
+		})
+		delete(elem, "action")
+		elem["action_profile_path"] = rule.TlsProfile
+		rulesList = append(rulesList, elem)
+	}
+
+	return d.Set("rule", rulesList)
+}
+
+func getPolicyTLSInspectionPolicyFromSchema(d *schema.ResourceData) model.TlsPolicy {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	sequenceNumber := int64(d.Get("sequence_number").(int))
+
+	return model.TlsPolicy{
+		DisplayName:    &displayName,
+		Description:    &description,
+		Tags:           tags,
+		SequenceNumber: &sequenceNumber,
+		Rules:          getPolicyTLSInspectionRulesFromSchema(d),
+	}
+}
+
+func resourceNsxtPolicyTLSInspectionPolicyCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return resourceNotSupportedError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyTLSInspectionPolicyExists)
+	if err != nil {
+		return err
+	}
+
+	obj := getPolicyTLSInspectionPolicyFromSchema(d)
+
+	// Create the resource using PATCH
+	log.Printf("[INFO] Creating TLS Inspection Policy with ID %s", id)
+	client := infra.NewTlsInspectionPoliciesClient(connector)
+	_, err = client.Patch(id, obj)
+	if err != nil {
+		return handleCreateError("TLS Inspection Policy", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyTLSInspectionPolicyRead(d, m)
+}
+
+func resourceNsxtPolicyTLSInspectionPolicyRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TLS Inspection Policy ID")
+	}
+
+	client := infra.NewTlsInspectionPoliciesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "TLS Inspection Policy", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("sequence_number", obj.SequenceNumber)
+
+	return setPolicyTLSInspectionRulesInSchema(d, obj.Rules)
+}
+
+func resourceNsxtPolicyTLSInspectionPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TLS Inspection Policy ID")
+	}
+
+	obj := getPolicyTLSInspectionPolicyFromSchema(d)
+	revision := int64(d.Get("revision").(int))
+	obj.Revision = &revision
+
+	// Update the resource using PUT, so that rules removed from configuration
+	// are deleted on NSX
+	client := infra.NewTlsInspectionPoliciesClient(connector)
+	_, err := client.Update(id, obj)
+	if err != nil {
+		return handleUpdateError("TLS Inspection Policy", id, err)
+	}
+
+	return resourceNsxtPolicyTLSInspectionPolicyRead(d, m)
+}
+
+func resourceNsxtPolicyTLSInspectionPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TLS Inspection Policy ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewTlsInspectionPoliciesClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("TLS Inspection Policy", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyTLSInspectionPolicy_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_tls_inspection_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_CERTIFICATE_NAME")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_tls_inspection_policy", resourceNsxtPolicyTLSInspectionPolicyExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTLSInspectionPolicyTemplate(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyTLSInspectionPolicyExists),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", "rule1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.sequence_number", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.scope.#", "1"),
+					resource.TestCheckResourceAttrPair(testResourceName, "rule.0.action_profile_path", "nsxt_policy_tls_inspection_action_profile.test", "path"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.display_name", "rule2"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.logged", "true"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rule.0.nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTLSInspectionPolicyTemplate(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyResourceExists(testResourceName, resourceNsxtPolicyTLSInspectionPolicyExists),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", "rule1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyTLSInspectionPolicy_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_tls_inspection_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_CERTIFICATE_NAME")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyResourceCheckDestroy(state, name, "nsxt_policy_tls_inspection_policy", resourceNsxtPolicyTLSInspectionPolicyExists)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTLSInspectionPolicyTemplate(name, true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyTLSInspectionPolicyTemplate(name string, withSecondRule bool) string {
+	secondRule := ""
+	if withSecondRule {
+		secondRule = `
+  rule {
+    display_name        = "rule2"
+    logged              = true
+    action_profile_path = nsxt_policy_tls_inspection_action_profile.test.path
+    scope               = [nsxt_policy_tier1_gateway.test.path]
+  }`
+	}
+
+	return testAccNsxtPolicyEdgeClusterReadTemplate(getEdgeClusterName()) +
+		testAccNsxtPolicyTier1WithEdgeClusterTemplate("test", false) +
+		testAccNsxtPolicyTLSInspectionActionProfileInternalTemplate(name, "BLOCK", "BALANCED") + fmt.Sprintf(`
+
+resource "nsxt_policy_tls_inspection_policy" "test" {
+  display_name = "%s"
+  description  = "Acceptance Test"
+
+  rule {
+    display_name        = "rule1"
+    direction           = "IN"
+    action_profile_path = nsxt_policy_tls_inspection_action_profile.test.path
+    scope               = [nsxt_policy_tier1_gateway.test.path]
+  }
+%s
+}`, name, secondRule)
+}
@@ -0,0 +1,431 @@
+/* Copyright © 2022 VMware, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	api "github.com/vmware/go-vmware-nsxt"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	gm_gateway_policies "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/domains/gateway_policies"
+	gm_security_policies "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/domains/security_policies"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains/gateway_policies"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains/security_policies"
+)
+
+// Sweepers clean up objects leaked by failed acceptance runs. They are invoked with
+// go test ./nsxt -v -sweep=all (use -sweep-run to select specific sweepers), and
+// only objects with display name starting with the sweep prefix are deleted.
+// Dependencies ensure objects are deleted before the objects they refer to:
+// rules, then policies, then groups, then segments, then tier-1s, then tier-0s.
+
+var testAccSweeperClients interface{}
+
+type testAccSweepDeleter func(id string, path string, m interface{}) error
+
+func init() {
+	resource.AddTestSweepers("nsxt_policy_rule", &resource.Sweeper{
+		Name: "nsxt_policy_rule",
+		F:    testAccNsxtPolicyRuleSweep,
+	})
+	resource.AddTestSweepers("nsxt_policy_security_policy", &resource.Sweeper{
+		Name:         "nsxt_policy_security_policy",
+		Dependencies: []string{"nsxt_policy_rule"},
+		F:            testAccNsxtPolicySweepFunc("SecurityPolicy", testAccNsxtSweepWithResource(resourceNsxtPolicySecurityPolicy())),
+	})
+	resource.AddTestSweepers("nsxt_policy_gateway_policy", &resource.Sweeper{
+		Name:         "nsxt_policy_gateway_policy",
+		Dependencies: []string{"nsxt_policy_rule"},
+		F:            testAccNsxtPolicySweepFunc("GatewayPolicy", testAccNsxtSweepWithResource(resourceNsxtPolicyGatewayPolicy())),
+	})
+	resource.AddTestSweepers("nsxt_policy_group", &resource.Sweeper{
+		Name:         "nsxt_policy_group",
+		Dependencies: []string{"nsxt_policy_security_policy", "nsxt_policy_gateway_policy"},
+		F:            testAccNsxtPolicySweepFunc("Group", testAccNsxtSweepWithResource(resourceNsxtPolicyGroup())),
+	})
+	resource.AddTestSweepers("nsxt_policy_lb_virtual_server", &resource.Sweeper{
+		Name: "nsxt_policy_lb_virtual_server",
+		F:    testAccNsxtPolicySweepFunc("LBVirtualServer", testAccNsxtSweepWithResource(resourceNsxtPolicyLBVirtualServer())),
+	})
+	resource.AddTestSweepers("nsxt_policy_lb_pool", &resource.Sweeper{
+		Name:         "nsxt_policy_lb_pool",
+		Dependencies: []string{"nsxt_policy_lb_virtual_server"},
+		F:            testAccNsxtPolicySweepFunc("LBPool", testAccNsxtSweepWithResource(resourceNsxtPolicyLBPool())),
+	})
+	resource.AddTestSweepers("nsxt_policy_lb_service", &resource.Sweeper{
+		Name:         "nsxt_policy_lb_service",
+		Dependencies: []string{"nsxt_policy_lb_virtual_server"},
+		F:            testAccNsxtPolicySweepFunc("LBService", testAccNsxtSweepWithResource(resourceNsxtPolicyLBService())),
+	})
+	resource.AddTestSweepers("nsxt_policy_segment", &resource.Sweeper{
+		Name:         "nsxt_policy_segment",
+		Dependencies: []string{"nsxt_policy_group"},
+		F:            testAccNsxtPolicySweepFunc("Segment", testAccNsxtPolicySegmentSweepDelete),
+	})
+	resource.AddTestSweepers("nsxt_policy_tier1_gateway", &resource.Sweeper{
+		Name:         "nsxt_policy_tier1_gateway",
+		Dependencies: []string{"nsxt_policy_segment", "nsxt_policy_lb_service"},
+		F:            testAccNsxtPolicySweepFunc("Tier1", testAccNsxtSweepWithResource(resourceNsxtPolicyTier1Gateway())),
+	})
+	resource.AddTestSweepers("nsxt_policy_tier0_gateway", &resource.Sweeper{
+		Name:         "nsxt_policy_tier0_gateway",
+		Dependencies: []string{"nsxt_policy_tier1_gateway"},
+		F:            testAccNsxtPolicySweepFunc("Tier0", testAccNsxtSweepWithResource(resourceNsxtPolicyTier0Gateway())),
+	})
+
+	resource.AddTestSweepers("nsxt_firewall_section", &resource.Sweeper{
+		Name: "nsxt_firewall_section",
+		F:    testAccNsxtMPSweepFunc("FirewallSection", testAccNsxtFirewallSectionSweepList, testAccNsxtSweepWithResource(resourceNsxtFirewallSection())),
+	})
+	resource.AddTestSweepers("nsxt_ns_group", &resource.Sweeper{
+		Name:         "nsxt_ns_group",
+		Dependencies: []string{"nsxt_firewall_section"},
+		F:            testAccNsxtMPSweepFunc("NSGroup", testAccNsxtNsGroupSweepList, testAccNsxtSweepWithResource(resourceNsxtNsGroup())),
+	})
+	resource.AddTestSweepers("nsxt_ip_set", &resource.Sweeper{
+		Name:         "nsxt_ip_set",
+		Dependencies: []string{"nsxt_firewall_section", "nsxt_ns_group"},
+		F:            testAccNsxtMPSweepFunc("IPSet", testAccNsxtIPSetSweepList, testAccNsxtSweepWithResource(resourceNsxtIPSet())),
+	})
+	resource.AddTestSweepers("nsxt_lb_virtual_server", &resource.Sweeper{
+		Name: "nsxt_lb_virtual_server",
+		F:    testAccNsxtMPSweepFunc("LbVirtualServer", testAccNsxtLbVirtualServerSweepList, testAccNsxtSweepWithResource(resourceNsxtLbHTTPVirtualServer())),
+	})
+	resource.AddTestSweepers("nsxt_lb_pool", &resource.Sweeper{
+		Name:         "nsxt_lb_pool",
+		Dependencies: []string{"nsxt_lb_virtual_server"},
+		F:            testAccNsxtMPSweepFunc("LbPool", testAccNsxtLbPoolSweepList, testAccNsxtSweepWithResource(resourceNsxtLbPool())),
+	})
+	resource.AddTestSweepers("nsxt_lb_service", &resource.Sweeper{
+		Name:         "nsxt_lb_service",
+		Dependencies: []string{"nsxt_lb_virtual_server"},
+		F:            testAccNsxtMPSweepFunc("LbService", testAccNsxtLbServiceSweepList, testAccNsxtSweepWithResource(resourceNsxtLbService())),
+	})
+	resource.AddTestSweepers("nsxt_logical_switch", &resource.Sweeper{
+		Name:         "nsxt_logical_switch",
+		Dependencies: []string{"nsxt_ns_group"},
+		F:            testAccNsxtMPSweepFunc("LogicalSwitch", testAccNsxtLogicalSwitchSweepList, testAccNsxtSweepWithResource(resourceNsxtLogicalSwitch())),
+	})
+	resource.AddTestSweepers("nsxt_logical_tier1_router", &resource.Sweeper{
+		Name:         "nsxt_logical_tier1_router",
+		Dependencies: []string{"nsxt_lb_service", "nsxt_logical_switch"},
+		F:            testAccNsxtMPSweepFunc("LogicalTier1Router", testAccNsxtLogicalRouterSweepList("TIER1"), testAccNsxtLogicalRouterSweepDelete),
+	})
+	resource.AddTestSweepers("nsxt_logical_tier0_router", &resource.Sweeper{
+		Name:         "nsxt_logical_tier0_router",
+		Dependencies: []string{"nsxt_logical_tier1_router"},
+		F:            testAccNsxtMPSweepFunc("LogicalTier0Router", testAccNsxtLogicalRouterSweepList("TIER0"), testAccNsxtLogicalRouterSweepDelete),
+	})
+}
+
+func getTestSweepPrefix() string {
+	prefix := os.Getenv("NSXT_TEST_SWEEP_PREFIX")
+	if prefix == "" {
+		return defaultTestResourceName
+	}
+	return prefix
+}
+
+func testAccGetSweeperClients() (interface{}, error) {
+	if testAccSweeperClients != nil {
+		return testAccSweeperClients, nil
+	}
+
+	diags := testAccProvider.Configure(context.Background(), terraform.NewResourceConfigRaw(nil))
+	if diags.HasError() {
+		return nil, fmt.Errorf("Failed to configure provider for sweepers: %v", diags)
+	}
+
+	testAccSweeperClients = testAccProvider.Meta()
+	return testAccSweeperClients, nil
+}
+
+func testAccNsxtSweepWithResource(r *schema.Resource) testAccSweepDeleter {
+	return func(id string, path string, m interface{}) error {
+		d := r.Data(nil)
+		d.SetId(id)
+		if _, ok := r.Schema["domain"]; ok {
+			d.Set("domain", getDomainFromResourcePath(path))
+		}
+		return r.Delete(d, m)
+	}
+}
+
+func testAccNsxtSweepDeleteAll(objType string, found map[string]string, paths map[string]string, deleter testAccSweepDeleter, m interface{}) error {
+	var failures []string
+	for id, name := range found {
+		log.Printf("[INFO] Sweeping %s %s (%s)", objType, name, id)
+		err := deleter(id, paths[id], m)
+		if err != nil {
+			log.Printf("[ERROR] Failed to sweep %s %s: %v", objType, id, err)
+			failures = append(failures, fmt.Sprintf("%s: %v", id, err))
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("Failed to sweep %d %s objects:\n%s", len(failures), objType, strings.Join(failures, "\n"))
+	}
+
+	return nil
+}
+
+func testAccNsxtPolicySweepFunc(resourceType string, deleter testAccSweepDeleter) func(string) error {
+	return func(region string) error {
+		m, err := testAccGetSweeperClients()
+		if err != nil {
+			return err
+		}
+
+		found, paths, err := testAccNsxtPolicySweepList(m, resourceType)
+		if err != nil {
+			return err
+		}
+
+		return testAccNsxtSweepDeleteAll(resourceType, found, paths, deleter, m)
+	}
+}
+
+func testAccNsxtPolicySweepList(m interface{}, resourceType string) (map[string]string, map[string]string, error) {
+	prefix := getTestSweepPrefix()
+	found := make(map[string]string)
+	paths := make(map[string]string)
+	resultValues, err := listPolicyResourcesByNameAndType(getPolicyConnector(m), isPolicyGlobalManager(m), prefix, resourceType, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to search for %s objects: %v", resourceType, err)
+	}
+
+	converter := bindings.NewTypeConverter()
+	converter.SetMode(bindings.REST)
+	for _, result := range resultValues {
+		dataValue, errors := converter.ConvertToGolang(result, gm_model.PolicyResourceBindingType())
+		if len(errors) > 0 {
+			return nil, nil, errors[0]
+		}
+		policyResource := dataValue.(gm_model.PolicyResource)
+		// Search matches tokens, so verify actual prefix
+		if policyResource.DisplayName == nil || !strings.HasPrefix(*policyResource.DisplayName, prefix) {
+			continue
+		}
+		found[*policyResource.Id] = *policyResource.DisplayName
+		paths[*policyResource.Id] = *policyResource.Path
+	}
+
+	return found, paths, nil
+}
+
+// Rules are normally removed together with their parent policy. Rules with sweep prefix that
+// were leaked into other policies are removed individually before policies are swept.
+func testAccNsxtPolicyRuleSweep(region string) error {
+	m, err := testAccGetSweeperClients()
+	if err != nil {
+		return err
+	}
+
+	found, paths, err := testAccNsxtPolicySweepList(m, "Rule")
+	if err != nil {
+		return err
+	}
+
+	return testAccNsxtSweepDeleteAll("Rule", found, paths, testAccNsxtPolicyRuleSweepDelete, m)
+}
+
+func testAccNsxtPolicyRuleSweepDelete(id string, path string, m interface{}) error {
+	connector := getPolicyConnector(m)
+	domain := getDomainFromResourcePath(path)
+	if policyID := getResourceIDFromResourcePath(path, "security-policies"); policyID != "" {
+		if isPolicyGlobalManager(m) {
+			return gm_security_policies.NewRulesClient(connector).Delete(domain, policyID, id)
+		}
+		return security_policies.NewRulesClient(connector).Delete(domain, policyID, id)
+	}
+	if policyID := getResourceIDFromResourcePath(path, "gateway-policies"); policyID != "" {
+		if isPolicyGlobalManager(m) {
+			return gm_gateway_policies.NewRulesClient(connector).Delete(domain, policyID, id)
+		}
+		return gateway_policies.NewRulesClient(connector).Delete(domain, policyID, id)
+	}
+
+	log.Printf("[WARNING] Skipping rule %s with unsupported parent path", path)
+	return nil
+}
+
+func testAccNsxtPolicySegmentSweepDelete(id string, path string, m interface{}) error {
+	isFixed, gwPath, _ := parseSegmentPolicyPath(path)
+	if !isFixed {
+		return testAccNsxtSweepWithResource(resourceNsxtPolicySegment())(id, path, m)
+	}
+
+	r := resourceNsxtPolicyFixedSegment()
+	d := r.Data(nil)
+	d.SetId(id)
+	d.Set("connectivity_path", gwPath)
+	return r.Delete(d, m)
+}
+
+type testAccMPSweepLister func(nsxClient *api.APIClient, info *paginationInfo, found map[string]string) error
+
+func testAccNsxtMPSweepFunc(objType string, lister testAccMPSweepLister, deleter testAccSweepDeleter) func(string) error {
+	return func(region string) error {
+		m, err := testAccGetSweeperClients()
+		if err != nil {
+			return err
+		}
+
+		nsxClient := m.(nsxtClients).NsxtClient
+		if nsxClient == nil {
+			log.Printf("[INFO] Skipping %s sweep since MP API is not available", objType)
+			return nil
+		}
+
+		prefix := getTestSweepPrefix()
+		listed := make(map[string]string)
+		_, err = handlePagination(func(info *paginationInfo) error {
+			return lister(nsxClient, info, listed)
+		})
+		if err != nil {
+			return fmt.Errorf("Failed to list %s objects: %v", objType, err)
+		}
+
+		found := make(map[string]string)
+		for id, name := range listed {
+			if strings.HasPrefix(name, prefix) {
+				found[id] = name
+			}
+		}
+
+		return testAccNsxtSweepDeleteAll(objType, found, nil, deleter, m)
+	}
+}
+
+func testAccNsxtFirewallSectionSweepList(nsxClient *api.APIClient, info *paginationInfo, found map[string]string) error {
+	objList, _, err := nsxClient.ServicesApi.ListSections(nsxClient.Context, info.LocalVarOptionals)
+	if err != nil {
+		return err
+	}
+	info.PageCount = int64(len(objList.Results))
+	info.TotalCount = objList.ResultCount
+	info.Cursor = objList.Cursor
+	for _, obj := range objList.Results {
+		found[obj.Id] = obj.DisplayName
+	}
+	return nil
+}
+
+func testAccNsxtNsGroupSweepList(nsxClient *api.APIClient, info *paginationInfo, found map[string]string) error {
+	objList, _, err := nsxClient.GroupingObjectsApi.ListNSGroups(nsxClient.Context, info.LocalVarOptionals)
+	if err != nil {
+		return err
+	}
+	info.PageCount = int64(len(objList.Results))
+	info.TotalCount = objList.ResultCount
+	info.Cursor = objList.Cursor
+	for _, obj := range objList.Results {
+		found[obj.Id] = obj.DisplayName
+	}
+	return nil
+}
+
+func testAccNsxtIPSetSweepList(nsxClient *api.APIClient, info *paginationInfo, found map[string]string) error {
+	objList, _, err := nsxClient.GroupingObjectsApi.ListIPSets(nsxClient.Context, info.LocalVarOptionals)
+	if err != nil {
+		return err
+	}
+	info.PageCount = int64(len(objList.Results))
+	info.TotalCount = objList.ResultCount
+	info.Cursor = objList.Cursor
+	for _, obj := range objList.Results {
+		found[obj.Id] = obj.DisplayName
+	}
+	return nil
+}
+
+func testAccNsxtLbVirtualServerSweepList(nsxClient *api.APIClient, info *paginationInfo, found map[string]string) error {
+	objList, _, err := nsxClient.ServicesApi.ListLoadBalancerVirtualServers(nsxClient.Context, info.LocalVarOptionals)
+	if err != nil {
+		return err
+	}
+	info.PageCount = int64(len(objList.Results))
+	info.TotalCount = objList.ResultCount
+	info.Cursor = objList.Cursor
+	for _, obj := range objList.Results {
+		found[obj.Id] = obj.DisplayName
+	}
+	return nil
+}
+
+func testAccNsxtLbPoolSweepList(nsxClient *api.APIClient, info *paginationInfo, found map[string]string) error {
+	objList, _, err := nsxClient.ServicesApi.ListLoadBalancerPools(nsxClient.Context, info.LocalVarOptionals)
+	if err != nil {
+		return err
+	}
+	info.PageCount = int64(len(objList.Results))
+	info.TotalCount = objList.ResultCount
+	info.Cursor = objList.Cursor
+	for _, obj := range objList.Results {
+		found[obj.Id] = obj.DisplayName
+	}
+	return nil
+}
+
+func testAccNsxtLbServiceSweepList(nsxClient *api.APIClient, info *paginationInfo, found map[string]string) error {
+	objList, _, err := nsxClient.ServicesApi.ListLoadBalancerServices(nsxClient.Context, info.LocalVarOptionals)
+	if err != nil {
+		return err
+	}
+	info.PageCount = int64(len(objList.Results))
+	info.TotalCount = objList.ResultCount
+	info.Cursor = objList.Cursor
+	for _, obj := range objList.Results {
+		found[obj.Id] = obj.DisplayName
+	}
+	return nil
+}
+
+func testAccNsxtLogicalSwitchSweepList(nsxClient *api.APIClient, info *paginationInfo, found map[string]string) error {
+	objList, _, err := nsxClient.LogicalSwitchingApi.ListLogicalSwitches(nsxClient.Context, info.LocalVarOptionals)
+	if err != nil {
+		return err
+	}
+	info.PageCount = int64(len(objList.Results))
+	info.TotalCount = objList.ResultCount
+	info.Cursor = objList.Cursor
+	for _, obj := range objList.Results {
+		found[obj.Id] = obj.DisplayName
+	}
+	return nil
+}
+
+func testAccNsxtLogicalRouterSweepList(routerType string) testAccMPSweepLister {
+	return func(nsxClient *api.APIClient, info *paginationInfo, found map[string]string) error {
+		info.LocalVarOptionals["routerType"] = routerType
+		objList, _, err := nsxClient.LogicalRoutingAndServicesApi.ListLogicalRouters(nsxClient.Context, info.LocalVarOptionals)
+		if err != nil {
+			return err
+		}
+		info.PageCount = int64(len(objList.Results))
+		info.TotalCount = objList.ResultCount
+		info.Cursor = objList.Cursor
+		for _, obj := range objList.Results {
+			found[obj.Id] = obj.DisplayName
+		}
+		return nil
+	}
+}
+
+func testAccNsxtLogicalRouterSweepDelete(id string, path string, m interface{}) error {
+	// Router ports left by failed runs would block regular delete
+	nsxClient := m.(nsxtClients).NsxtClient
+	localVarOptionals := make(map[string]interface{})
+	localVarOptionals["force"] = true
+	_, err := nsxClient.LogicalRoutingAndServicesApi.DeleteLogicalRouter(nsxClient.Context, id, localVarOptionals)
+	return err
+}
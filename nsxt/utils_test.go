@@ -126,6 +126,46 @@ func getTestVMName() string {
 	return os.Getenv("NSXT_TEST_VM_NAME")
 }
 
+func getTestLdapServer() string {
+	return os.Getenv("NSXT_TEST_LDAP_SERVER")
+}
+
+func getTestLdapUsername() string {
+	return os.Getenv("NSXT_TEST_LDAP_USERNAME")
+}
+
+func getTestLdapPassword() string {
+	return os.Getenv("NSXT_TEST_LDAP_PASSWORD")
+}
+
+func getTestTLSConfigProfilePath() string {
+	return os.Getenv("NSXT_TEST_TLS_CONFIG_PROFILE_PATH")
+}
+
+func getTestServiceSegmentPath() string {
+	return os.Getenv("NSXT_TEST_SERVICE_SEGMENT_PATH")
+}
+
+func getTestServiceProfilePath() string {
+	return os.Getenv("NSXT_TEST_SERVICE_PROFILE_PATH")
+}
+
+func getTestPartnerServiceName() string {
+	return os.Getenv("NSXT_TEST_PARTNER_SERVICE_NAME")
+}
+
+func getTestComputeClusterID() string {
+	return os.Getenv("NSXT_TEST_COMPUTE_CLUSTER_ID")
+}
+
+func getTestIdsSignatureID() string {
+	return os.Getenv("NSXT_TEST_IDS_SIGNATURE_ID")
+}
+
+func getTestEndpointServiceProfilePath() string {
+	return os.Getenv("NSXT_TEST_ENDPOINT_SERVICE_PROFILE_PATH")
+}
+
 func getTestSiteName() string {
 	return os.Getenv("NSXT_TEST_SITE_NAME")
 }
@@ -492,3 +532,58 @@ func testAccNsxtPolicyResourceCheckDestroy(state *terraform.State, displayName s
 	}
 	return nil
 }
+
+func testAccNsxtPolicyProfileBindingExists(resourceName string, binding policyProfileBindingResource) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy %s resource %s not found in resources", binding.name, resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy %s resource ID not set in resources", binding.name)
+		}
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+		exists, err := binding.existsPartial(rs.Primary.Attributes[binding.parentAttribute])(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy %s %s does not exist", binding.name, resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyProfileBindingCheckDestroy(state *terraform.State, resourceType string, binding policyProfileBindingResource) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != resourceType {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := binding.existsPartial(rs.Primary.Attributes[binding.parentAttribute])(resourceID, connector, testAccIsGlobalManager())
+		if err == nil && exists {
+			return fmt.Errorf("Policy %s %s still exists", binding.name, resourceID)
+		}
+	}
+	return nil
+}
+
+func testAccResourceNsxtPolicyPathImporterGetID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("NSX Policy resource %s not found in resources", resourceName)
+		}
+		path := rs.Primary.Attributes["path"]
+		if path == "" {
+			return "", fmt.Errorf("NSX Policy resource %s path not set in resources", resourceName)
+		}
+		return path, nil
+	}
+}